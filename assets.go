@@ -0,0 +1,80 @@
+package swagin
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/x-research-team/swagin/swagger"
+)
+
+//go:generate sh assets/update.sh
+
+// Versions of the embedded documentation bundles, also used for the CDN URLs.
+const (
+	SwaggerUIVersion = "5.29.0"
+	RedocVersion     = "2.0.0-rc.59"
+)
+
+// Major versions of the documentation UIs that are only loaded from jsDelivr.
+const (
+	ScalarVersion   = "1"
+	RapiDocVersion  = "9"
+	ElementsVersion = "8"
+)
+
+//go:embed assets/swagger-ui assets/redoc
+var assets embed.FS
+
+func (g *SwaGin) initAssets() {
+	if g.Swagger.CDN {
+		return
+	}
+	g.serveAssets(g.assetsUrl(swagger.SwaggerUI), "assets/swagger-ui")
+	g.serveAssets(g.assetsUrl(swagger.Redoc), "assets/redoc")
+}
+
+// serveAssets serves the embedded dir at url, which contains the version of
+// the bundle so it can be cached forever.
+func (g *SwaGin) serveAssets(url string, dir string) {
+	sub, err := fs.Sub(assets, dir)
+	if err != nil {
+		panic(err)
+	}
+	fileServer := http.StripPrefix(url, http.FileServer(http.FS(sub)))
+	g.docs.GET(url+"/*filepath", func(c *gin.Context) {
+		if strings.HasSuffix(c.Param("filepath"), "/") {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		fileServer.ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+// assetsUrl returns the url the bundle of the named documentation UI is
+// loaded from.
+func (g *SwaGin) assetsUrl(ui string) string {
+	switch ui {
+	case swagger.SwaggerUI:
+		if g.Swagger.CDN {
+			return "https://cdn.jsdelivr.net/npm/swagger-ui-dist@" + SwaggerUIVersion
+		}
+		return g.fullPath(g.Swagger.AssetsUrl) + "/swagger-ui@" + SwaggerUIVersion
+	case swagger.Redoc:
+		if g.Swagger.CDN {
+			return "https://cdn.jsdelivr.net/npm/redoc@" + RedocVersion + "/bundles"
+		}
+		return g.fullPath(g.Swagger.AssetsUrl) + "/redoc@" + RedocVersion
+	case swagger.Scalar:
+		return "https://cdn.jsdelivr.net/npm/@scalar/api-reference@" + ScalarVersion
+	case swagger.RapiDoc:
+		return "https://cdn.jsdelivr.net/npm/rapidoc@" + RapiDocVersion + "/dist"
+	case swagger.Elements:
+		return "https://cdn.jsdelivr.net/npm/@stoplight/elements@" + ElementsVersion
+	}
+	return ""
+}
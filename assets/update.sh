@@ -0,0 +1,37 @@
+#!/bin/sh
+# Downloads the Swagger UI and ReDoc bundles pinned in assets.go from the npm
+# registry into assets/. Run it with `go generate` after bumping a version.
+set -eu
+
+cd "$(dirname "$0")/.."
+
+version() {
+	sed -n "s/^[[:space:]]*$1[[:space:]]*= \"\(.*\)\"/\1/p" assets.go
+}
+
+SWAGGER_UI_VERSION=$(version SwaggerUIVersion)
+REDOC_VERSION=$(version RedocVersion)
+
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+fetch() {
+	mkdir -p "$tmp/$1"
+	curl -fsSL "https://registry.npmjs.org/$1/-/$1-$2.tgz" | tar -xz -C "$tmp/$1"
+}
+
+fetch swagger-ui-dist "$SWAGGER_UI_VERSION"
+for f in swagger-ui-bundle.js swagger-ui-standalone-preset.js swagger-ui.css favicon-16x16.png favicon-32x32.png LICENSE NOTICE; do
+	if [ -f "$tmp/swagger-ui-dist/package/$f" ]; then
+		cp "$tmp/swagger-ui-dist/package/$f" assets/swagger-ui/
+	fi
+done
+
+fetch redoc "$REDOC_VERSION"
+cp "$tmp/redoc/package/bundles/redoc.standalone.js" assets/redoc/
+cp "$tmp/redoc/package/LICENSE" assets/redoc/
+if [ -f "$tmp/redoc/package/bundles/redoc.standalone.js.LICENSE.txt" ]; then
+	cp "$tmp/redoc/package/bundles/redoc.standalone.js.LICENSE.txt" assets/redoc/
+fi
+
+echo "swagger-ui-dist $SWAGGER_UI_VERSION, redoc $REDOC_VERSION"
@@ -1,6 +1,7 @@
 package swagin
 
 import (
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/x-research-team/swagin/router"
 	"github.com/x-research-team/swagin/security"
@@ -32,6 +33,16 @@ func Tags(tags ...string) Option {
 	}
 }
 
+// Tag tag the routes of the group with name and describe the tag in the docs
+func Tag(name, description string) Option {
+	return func(g *Group) {
+		Tags(name)(g)
+		if g.Swagger != nil {
+			g.Swagger.AddTag(&openapi3.Tag{Name: name, Description: description})
+		}
+	}
+}
+
 func Security(securities ...security.ISecurity) Option {
 	return func(g *Group) {
 		g.Securities = append(g.Securities, securities...)
@@ -41,7 +52,9 @@ func Security(securities ...security.ISecurity) Option {
 func (g *Group) Handle(path string, method string, r *router.Router) {
 	router.Handlers(g.Handlers...)(r)
 	router.Tags(g.Tags...)(r)
-	router.Security(g.Securities...)(r)
+	if !r.Public {
+		router.Security(g.Securities...)(r)
+	}
 	g.SwaGin.Handle(g.Path+path, method, r)
 }
 func (g *Group) GET(path string, router *router.Router) {
@@ -64,6 +64,13 @@ func Exclude() Option {
 	}
 }
 
+// Public serve api without the securities of its groups and app, only its own
+func Public() Option {
+	return func(router *Router) {
+		router.Public = true
+	}
+}
+
 // ContentType Set request contentType
 func ContentType(contentType string, contentTypeType ContentTypeType) Option {
 	return func(router *Router) {
@@ -74,3 +81,18 @@ func ContentType(contentType string, contentTypeType ContentTypeType) Option {
 		}
 	}
 }
+
+// Versions serve api only in versions of the app
+func Versions(versions ...string) Option {
+	return func(router *Router) {
+		router.Versions = append(router.Versions, versions...)
+	}
+}
+
+// VersionRange serve api from version from to version to of the app, both included, empty for no bound
+func VersionRange(from, to string) Option {
+	return func(router *Router) {
+		router.VersionFrom = from
+		router.VersionTo = to
+	}
+}
@@ -7,7 +7,9 @@ package router
 import (
 	"container/list"
 	"log"
+	"maps"
 	"net/http"
+	"slices"
 	"github.com/goccy/go-reflect"
 
 	"github.com/gin-gonic/gin/binding"
@@ -33,15 +35,35 @@ type Router struct {
 	ResponseContentType string
 	Tags                []string
 	API                 gin.HandlerFunc
+	Handler             any
 	Model               Model
 	OperationID         string
 	Exclude             bool
+	Public              bool
 	Securities          []security.ISecurity
 	Response            Response
+	Versions            []string
+	VersionFrom         string
+	VersionTo           string
 }
 
 var validate = validator.New()
 
+// ErrorHandlerKey is the key of the error handler of the app in the context,
+// which handles the errors of BindModel.
+const ErrorHandlerKey = "error_handler"
+
+// bindError reports err to the error handler of the app and aborts, it
+// panics without one.
+func bindError(c *gin.Context, err error, status int) {
+	handler, ok := c.Value(ErrorHandlerKey).(ErrorHandlerFunc)
+	if !ok {
+		log.Panic(err)
+	}
+	handler(c, err, status)
+	c.Abort()
+}
+
 func BindModel(req any) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		m := reflect.New(reflect.TypeOf(req).Elem())
@@ -52,7 +74,8 @@ func BindModel(req any) gin.HandlerFunc {
 		if header.IsValid() {
 			headerValue := header.Interface()
 			if err := c.ShouldBindWith(&headerValue, Header); err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusBadRequest)
+				return
 			}
 			header.Set(reflect.ValueOf(headerValue))
 		}
@@ -61,7 +84,8 @@ func BindModel(req any) gin.HandlerFunc {
 		if query.IsValid() {
 			queryValue := query.Interface()
 			if err := c.ShouldBindWith(&queryValue, Query); err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusBadRequest)
+				return
 			}
 			query.Set(reflect.ValueOf(queryValue))
 		}
@@ -73,31 +97,38 @@ func BindModel(req any) gin.HandlerFunc {
 				switch c.Request.Header.Get("Content-Type") {
 				case binding.MIMEMultipartPOSTForm:
 					if err := c.ShouldBindWith(&bodyValue, binding.FormMultipart); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEJSON:
 					if err := c.ShouldBindWith(&bodyValue, binding.JSON); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEXML:
 					if err := c.ShouldBindWith(&bodyValue, binding.XML); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEPOSTForm:
 					if err := c.ShouldBindWith(&bodyValue, binding.Form); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEYAML:
 					if err := c.ShouldBindWith(&bodyValue, binding.YAML); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEPROTOBUF:
 					if err := c.ShouldBindWith(&bodyValue, binding.ProtoBuf); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				case binding.MIMEMSGPACK:
 					if err := c.ShouldBindWith(&bodyValue, binding.MsgPack); err != nil {
-						log.Panic(err)
+						bindError(c, err, http.StatusBadRequest)
+						return
 					}
 				}
 			}
@@ -106,10 +137,12 @@ func BindModel(req any) gin.HandlerFunc {
 				Result:  &bodyReq,
 			})
 			if err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusInternalServerError)
+				return
 			}
 			if err := decoder.Decode(bodyValue); err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusBadRequest)
+				return
 			}
 			body.Set(reflect.ValueOf(bodyReq))
 		}
@@ -119,39 +152,70 @@ func BindModel(req any) gin.HandlerFunc {
 			uriValue := uri.Interface()
 			uriMap := make(map[string]string)
 			if err := c.ShouldBindUri(&uriMap); err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusBadRequest)
+				return
 			}
 			decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 				TagName: "uri",
 				Result:  &uriValue,
 			})
 			if err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusInternalServerError)
+				return
 			}
 			if err := decoder.Decode(uriMap); err != nil {
-				log.Panic(err)
+				bindError(c, err, http.StatusBadRequest)
+				return
 			}
 			uri.Set(reflect.ValueOf(uriValue))
 		}
 
+		auth := m.FieldByName("Auth")
+		if auth.IsValid() {
+			field, _ := m.Type().FieldByName("Auth")
+			if credentials, ok := authCredentials(c, field.Tag.Get("scheme"), auth.Type()); ok {
+				auth.Set(credentials)
+			}
+		}
+
 		model := m.Interface()
 
 		if err := validate.Struct(model); err != nil {
-			log.Panic(err)
-
+			bindError(c, err, http.StatusBadRequest)
+			return
 		}
 		if err := copier.Copy(req, model); err != nil {
-			log.Panic(err)
-
+			bindError(c, err, http.StatusInternalServerError)
+			return
 		}
 		c.Next()
 	}
 }
 
+// authCredentials returns the credentials of type t of the scheme named
+// scheme, or of any scheme that authorized the request when empty.
+func authCredentials(c *gin.Context, scheme string, t reflect.Type) (reflect.Value, bool) {
+	authorized := security.GetAuthorized(c)
+	schemes := []string{scheme}
+	if scheme == "" {
+		schemes = slices.Sorted(maps.Keys(authorized))
+	}
+	for _, name := range schemes {
+		credentials, ok := authorized[name]
+		if !ok || credentials == nil {
+			continue
+		}
+		if v := reflect.ValueOf(credentials); v.Type().AssignableTo(t) {
+			return v, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
 func (router *Router) GetHandlers() []gin.HandlerFunc {
 	var handlers []gin.HandlerFunc
-	for _, s := range router.Securities {
-		handlers = append(handlers, s.Authorize)
+	if len(router.Securities) != 0 {
+		handlers = append(handlers, security.All(router.Securities...).Authorize)
 	}
 	for h := router.Handlers.Front(); h != nil; h = h.Next() {
 		if f, ok := h.Value.(gin.HandlerFunc); ok {
@@ -169,6 +233,7 @@ func NewX(f gin.HandlerFunc, options ...Option) *Router {
 		API: func(ctx *gin.Context) {
 			f(ctx)
 		},
+		Handler: f,
 	}
 	for _, option := range options {
 		option(r)
@@ -184,7 +249,8 @@ func New[T Model, F func(c *gin.Context, req T)](f F, options ...Option) *Router
 		API: func(ctx *gin.Context) {
 			f(ctx, model)
 		},
-		Model: model,
+		Handler: f,
+		Model:   model,
 	}
 	for _, option := range options {
 		option(r)
@@ -229,7 +295,35 @@ func (router *Router) WithExclude() *Router {
 	Exclude()(router)
 	return router
 }
+func (router *Router) WithPublic() *Router {
+	Public()(router)
+	return router
+}
 func (router *Router) WithContentType(contentType string, contentTypeType ContentTypeType) *Router {
 	ContentType(contentType, contentTypeType)(router)
 	return router
 }
+func (router *Router) WithVersions(versions ...string) *Router {
+	Versions(versions...)(router)
+	return router
+}
+func (router *Router) WithVersionRange(from, to string) *Router {
+	VersionRange(from, to)(router)
+	return router
+}
+
+// InVersion reports whether the api is served in version, versions are the
+// versions of the app from the oldest to the newest.
+func (router *Router) InVersion(version string, versions []string) bool {
+	if len(router.Versions) != 0 {
+		return slices.Contains(router.Versions, version)
+	}
+	i := slices.Index(versions, version)
+	if router.VersionFrom != "" && i < slices.Index(versions, router.VersionFrom) {
+		return false
+	}
+	if router.VersionTo != "" && i > slices.Index(versions, router.VersionTo) {
+		return false
+	}
+	return true
+}
@@ -1,32 +1,110 @@
 package security
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
+	"strings"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 )
 
+// Locations of an api key.
+const (
+	InHeader = "header"
+	InQuery  = "query"
+	InCookie = "cookie"
+)
+
+// KeyValidator checks an api key, returning the credentials stored in the
+// context for a valid key.
+type KeyValidator func(c *gin.Context, key string) (any, error)
+
+var ErrInvalidKey = errors.New("invalid apikey")
+
 type ApiKey struct {
 	Security
+	// Name of the header, query parameter or cookie holding the key.
 	Name string
+	// In is where the key is read, InHeader when empty.
+	In string
+	// Validator checks the key, any non-empty key is accepted when nil.
+	Validator KeyValidator
 }
 
 func (k *ApiKey) Authorize(c *gin.Context) {
-	auth := c.Request.Header.Get(k.Name)
+	var auth string
+	switch k.in() {
+	case InQuery:
+		auth = c.Query(k.Name)
+	case InCookie:
+		auth, _ = c.Cookie(k.Name)
+	default:
+		auth = c.Request.Header.Get(k.Name)
+	}
 	if auth == "" {
 		k.Callback(c, nil, errors.New("empty apikey"))
-	} else {
+	} else if k.Validator == nil {
 		k.Callback(c, auth, nil)
+	} else {
+		credentials, err := k.Validator(c, auth)
+		k.Callback(c, credentials, err)
 	}
 }
 func (k *ApiKey) Provider() string {
-	return ApiKeyAuth
+	return k.provider(ApiKeyAuth)
 }
 
 func (k *ApiKey) Scheme() *openapi3.SecurityScheme {
 	return &openapi3.SecurityScheme{
-		Type: "http",
-		In:   "header",
-		Name: k.Name,
+		Type:        "apiKey",
+		In:          k.in(),
+		Name:        k.Name,
+		Description: k.Description,
+	}
+}
+
+func (k *ApiKey) in() string {
+	if k.In == "" {
+		return InHeader
+	}
+	return k.In
+}
+
+// StaticKeys accepts the keys, compared in constant time.
+func StaticKeys(keys ...string) KeyValidator {
+	return func(c *gin.Context, key string) (any, error) {
+		valid := 0
+		for _, k := range keys {
+			valid |= subtle.ConstantTimeCompare([]byte(k), []byte(key))
+		}
+		if valid == 0 {
+			return nil, ErrInvalidKey
+		}
+		return key, nil
+	}
+}
+
+// HashedKeys accepts the keys whose hex encoded SHA-256 hash is in hashes, so
+// the keys themselves aren't kept in the configuration.
+func HashedKeys(hashes ...string) KeyValidator {
+	set := make(map[[sha256.Size]byte]bool, len(hashes))
+	for _, hash := range hashes {
+		var sum [sha256.Size]byte
+		if len(hash) != hex.EncodedLen(sha256.Size) {
+			panic("security: invalid SHA-256 key hash " + hash)
+		}
+		if _, err := hex.Decode(sum[:], []byte(strings.ToLower(hash))); err != nil {
+			panic("security: invalid SHA-256 key hash " + hash)
+		}
+		set[sum] = true
+	}
+	return func(c *gin.Context, key string) (any, error) {
+		if !set[sha256.Sum256([]byte(key))] {
+			return nil, ErrInvalidKey
+		}
+		return key, nil
 	}
 }
@@ -0,0 +1,134 @@
+package security
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestApiKey(t *testing.T) {
+	sum := sha256.Sum256([]byte("hashed"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	tests := []struct {
+		name     string
+		key      *ApiKey
+		request  func(r *http.Request)
+		wantCode int
+		wantKey  string
+	}{
+		{
+			name:     "header",
+			key:      &ApiKey{Name: "X-Key"},
+			request:  func(r *http.Request) { r.Header.Set("X-Key", "key") },
+			wantCode: http.StatusOK,
+			wantKey:  "key",
+		},
+		{
+			name:     "header missing",
+			key:      &ApiKey{Name: "X-Key"},
+			request:  func(r *http.Request) { r.Header.Set("X-Other", "key") },
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "query",
+			key:      &ApiKey{Name: "key", In: InQuery},
+			request:  func(r *http.Request) { r.URL.RawQuery = "key=key" },
+			wantCode: http.StatusOK,
+			wantKey:  "key",
+		},
+		{
+			name:     "query ignores the header",
+			key:      &ApiKey{Name: "key", In: InQuery},
+			request:  func(r *http.Request) { r.Header.Set("key", "key") },
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "cookie",
+			key:      &ApiKey{Name: "key", In: InCookie},
+			request:  func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "key", Value: "key"}) },
+			wantCode: http.StatusOK,
+			wantKey:  "key",
+		},
+		{
+			name:     "static",
+			key:      &ApiKey{Name: "X-Key", Validator: StaticKeys("first", "second")},
+			request:  func(r *http.Request) { r.Header.Set("X-Key", "second") },
+			wantCode: http.StatusOK,
+			wantKey:  "second",
+		},
+		{
+			name:     "static invalid",
+			key:      &ApiKey{Name: "X-Key", Validator: StaticKeys("first", "second")},
+			request:  func(r *http.Request) { r.Header.Set("X-Key", "third") },
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "hashed",
+			key:      &ApiKey{Name: "X-Key", Validator: HashedKeys(hash)},
+			request:  func(r *http.Request) { r.Header.Set("X-Key", "hashed") },
+			wantCode: http.StatusOK,
+			wantKey:  "hashed",
+		},
+		{
+			name:     "hashed invalid",
+			key:      &ApiKey{Name: "X-Key", Validator: HashedKeys(hash)},
+			request:  func(r *http.Request) { r.Header.Set("X-Key", hash) },
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.GET("/", tt.key.Authorize, func(c *gin.Context) {
+				c.String(http.StatusOK, "%v", c.Value(Credentials))
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			tt.request(r)
+			engine.ServeHTTP(w, r)
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK && w.Body.String() != tt.wantKey {
+				t.Errorf("credentials = %q, want %q", w.Body.String(), tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestHashedKeysInvalidHash(t *testing.T) {
+	for _, hash := range []string{"", "abc", strings.Repeat("x", 64)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("HashedKeys(%q) didn't panic", hash)
+				}
+			}()
+			HashedKeys(hash)
+		}()
+	}
+}
+
+func TestApiKeyScheme(t *testing.T) {
+	for in, key := range map[string]*ApiKey{
+		InHeader: {Name: "X-Key"},
+		InQuery:  {Name: "X-Key", In: InQuery},
+		InCookie: {Name: "X-Key", In: InCookie},
+	} {
+		scheme := key.Scheme()
+		if scheme.Type != "apiKey" || scheme.In != in || scheme.Name != "X-Key" {
+			t.Errorf("scheme = %+v, want an apiKey X-Key in %s", scheme, in)
+		}
+		if err := scheme.Validate(t.Context()); err != nil {
+			t.Errorf("scheme in %s: %v", in, err)
+		}
+	}
+	if provider := (&ApiKey{}).Provider(); provider != ApiKeyAuth {
+		t.Errorf("provider = %q, want %q", provider, ApiKeyAuth)
+	}
+}
@@ -1,37 +1,78 @@
 package security
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
+	"fmt"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 )
 
+// BasicVerifier checks the password of a user, returning the credentials
+// stored in the context for a valid one.
+type BasicVerifier func(c *gin.Context, username, password string) (any, error)
+
+var ErrInvalidPassword = errors.New("invalid username or password")
+
 type Basic struct {
 	Security
+	// Realm of the challenge sent on failure, "Restricted" when empty.
+	Realm string
+	// Verifier checks the passwords, any user is accepted when nil.
+	Verifier BasicVerifier
 }
 
+// User is the credentials of a user authorized by Basic.
 type User struct {
 	Username string
-	Password string
 }
 
 func (b *Basic) Authorize(c *gin.Context) {
 	username, password, ok := c.Request.BasicAuth()
+	var credentials any = &User{Username: username}
+	var err error
 	if !ok {
-		b.Callback(c, nil, errors.New("parse authentication error"))
-	} else {
-		b.Callback(c, &User{
-			Username: username,
-			Password: password,
-		}, nil)
+		err = errors.New("parse authentication error")
+	} else if b.Verifier != nil {
+		credentials, err = b.Verifier(c, username, password)
+	}
+	if err != nil {
+		realm := b.Realm
+		if realm == "" {
+			realm = "Restricted"
+		}
+		c.Header("WWW-Authenticate", fmt.Sprintf(`Basic realm=%q, charset="UTF-8"`, realm))
+		b.Callback(c, nil, err)
+		return
 	}
+	b.Callback(c, credentials, nil)
 }
 func (b *Basic) Provider() string {
-	return BasicAuth
+	return b.provider(BasicAuth)
 }
 func (b *Basic) Scheme() *openapi3.SecurityScheme {
 	return &openapi3.SecurityScheme{
-		Type:   "http",
-		Scheme: "basic",
+		Type:        "http",
+		Scheme:      "basic",
+		Description: b.Description,
+	}
+}
+
+// StaticUsers accepts the users with their password in users, compared in
+// constant time.
+func StaticUsers(users map[string]string) BasicVerifier {
+	hashes := make(map[string][sha256.Size]byte, len(users))
+	for username, password := range users {
+		hashes[username] = sha256.Sum256([]byte(password))
+	}
+	return func(c *gin.Context, username, password string) (any, error) {
+		want, ok := hashes[username]
+		got := sha256.Sum256([]byte(password))
+		if subtle.ConstantTimeCompare(want[:], got[:]) != 1 || !ok {
+			return nil, ErrInvalidPassword
+		}
+		return &User{Username: username}, nil
 	}
 }
@@ -0,0 +1,38 @@
+package security
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+)
+
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func TestBasic(t *testing.T) {
+	users := StaticUsers(map[string]string{"alice": "secret", "bob": ""})
+	tests := []struct {
+		name          string
+		basic         *Basic
+		authorization string
+		wantCode      int
+		wantChallenge string
+	}{
+		{"valid", &Basic{Verifier: users}, basicAuth("alice", "secret"), http.StatusOK, ""},
+		{"empty password", &Basic{Verifier: users}, basicAuth("bob", ""), http.StatusOK, ""},
+		{"wrong password", &Basic{Verifier: users}, basicAuth("alice", "other"), http.StatusUnauthorized, `Basic realm="Restricted", charset="UTF-8"`},
+		{"unknown user", &Basic{Verifier: users}, basicAuth("carol", ""), http.StatusUnauthorized, `Basic realm="Restricted", charset="UTF-8"`},
+		{"no credentials", &Basic{Verifier: users}, "", http.StatusUnauthorized, `Basic realm="Restricted", charset="UTF-8"`},
+		{"realm", &Basic{Realm: "Admin", Verifier: users}, basicAuth("alice", "other"), http.StatusUnauthorized, `Basic realm="Admin", charset="UTF-8"`},
+		{"any user", &Basic{}, basicAuth("carol", "x"), http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, tt.basic, tt.authorization)
+			if w.Code != tt.wantCode || w.Header().Get("WWW-Authenticate") != tt.wantChallenge {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Header().Get("WWW-Authenticate"), tt.wantCode, tt.wantChallenge)
+			}
+		})
+	}
+}
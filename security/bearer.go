@@ -25,7 +25,7 @@ func (b *Bearer) Authorize(c *gin.Context) {
 	}
 }
 func (b *Bearer) Provider() string {
-	return BearerAuth
+	return b.provider(BearerAuth)
 }
 
 func (b *Bearer) Scheme() *openapi3.SecurityScheme {
@@ -33,5 +33,6 @@ func (b *Bearer) Scheme() *openapi3.SecurityScheme {
 		Type:         "http",
 		Scheme:       "bearer",
 		BearerFormat: "JWT",
+		Description:  b.Description,
 	}
 }
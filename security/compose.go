@@ -0,0 +1,248 @@
+package security
+
+import (
+	"bytes"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// Authorized is the key of the credentials of every security that authorized
+// the request, by provider.
+const Authorized = "authorized"
+
+// Composite is a security composed of others.
+type Composite interface {
+	// Requirements returns the alternatives satisfying the security, each
+	// listing the securities that must all authorize the request.
+	Requirements() [][]ISecurity
+}
+
+// Requirements returns the alternatives satisfying all of securities, like
+// the security requirements of an operation.
+func Requirements(securities ...ISecurity) [][]ISecurity {
+	alternatives := [][]ISecurity{{}}
+	for _, s := range securities {
+		requirements := [][]ISecurity{{s}}
+		if composite, ok := s.(Composite); ok {
+			requirements = composite.Requirements()
+		}
+		var next [][]ISecurity
+		for _, a := range alternatives {
+			for _, b := range requirements {
+				next = append(next, slices.Concat(a, b))
+			}
+		}
+		alternatives = next
+	}
+	return alternatives
+}
+
+// GetAuthorized returns the credentials of every security that authorized
+// the request, by provider.
+func GetAuthorized(c *gin.Context) map[string]any {
+	authorized, _ := c.Value(Authorized).(map[string]any)
+	return authorized
+}
+
+// AllOf is satisfied when all its securities authorize the request, in
+// order, listed in the docs as a single requirement.
+type AllOf struct {
+	Security
+	Securities []ISecurity
+}
+
+// All requires all of securities.
+func All(securities ...ISecurity) *AllOf {
+	return &AllOf{Securities: securities}
+}
+
+func (a *AllOf) Authorize(c *gin.Context) {
+	for _, s := range a.Securities {
+		s.Authorize(c)
+		if rejected(c) {
+			return
+		}
+		if _, ok := s.(Composite); !ok {
+			credentials, _ := c.Get(Credentials)
+			authorized := maps.Clone(GetAuthorized(c))
+			if authorized == nil {
+				authorized = make(map[string]any)
+			}
+			authorized[s.Provider()] = credentials
+			c.Set(Authorized, authorized)
+			c.Set(CredentialsKey(s.Provider()), credentials)
+		}
+	}
+}
+func (a *AllOf) Provider() string {
+	return ""
+}
+
+func (a *AllOf) Scheme() *openapi3.SecurityScheme {
+	return nil
+}
+
+func (a *AllOf) Requirements() [][]ISecurity {
+	return Requirements(a.Securities...)
+}
+
+// AnyOf is satisfied by the first of its securities authorizing the
+// request, tried in order, listed in the docs as alternative requirements.
+// When all fail the response of the first one is sent, or of the first one
+// rejecting valid credentials with 403, with the challenges of all.
+type AnyOf struct {
+	Security
+	Securities []ISecurity
+}
+
+// Any requires one of securities.
+func Any(securities ...ISecurity) *AnyOf {
+	return &AnyOf{Securities: securities}
+}
+
+// Optional requires one of securities or none, the credentials are missing
+// for anonymous requests.
+func Optional(securities ...ISecurity) *AnyOf {
+	return Any(append(securities, &Anonymous{})...)
+}
+
+func (a *AnyOf) Authorize(c *gin.Context) {
+	// try each security on a copy of c recording its response, keeping the
+	// request, keys, errors and headers of the first one authorizing it
+	var failures []*recorder
+	for _, s := range a.Securities {
+		rec := &recorder{ResponseWriter: c.Writer, header: make(http.Header), status: http.StatusOK}
+		cp := c.Copy()
+		cp.Writer = rec
+		All(s).Authorize(cp)
+		if !rejected(cp) {
+			c.Request = cp.Request
+			for k, v := range cp.Keys {
+				c.Set(k, v)
+			}
+			c.Errors = append(c.Errors, cp.Errors...)
+			maps.Copy(c.Writer.Header(), rec.header)
+			return
+		}
+		failures = append(failures, rec)
+	}
+	if len(failures) == 0 {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	failure := failures[0]
+	for _, f := range failures {
+		if f.status == http.StatusForbidden {
+			failure = f
+			break
+		}
+	}
+	header := c.Writer.Header()
+	for k, v := range failure.header {
+		if k != "Www-Authenticate" {
+			header[k] = v
+		}
+	}
+	for _, f := range failures {
+		for _, challenge := range f.header.Values("WWW-Authenticate") {
+			header.Add("WWW-Authenticate", challenge)
+		}
+	}
+	c.AbortWithStatus(failure.status)
+	_, _ = c.Writer.Write(failure.body.Bytes())
+}
+func (a *AnyOf) Provider() string {
+	return ""
+}
+
+func (a *AnyOf) Scheme() *openapi3.SecurityScheme {
+	return nil
+}
+
+func (a *AnyOf) Requirements() [][]ISecurity {
+	var requirements [][]ISecurity
+	for _, s := range a.Securities {
+		requirements = append(requirements, Requirements(s)...)
+	}
+	return requirements
+}
+
+// Anonymous authorizes every request, listed in the docs as the empty
+// requirement. Use it in an AnyOf to allow anonymous requests.
+type Anonymous struct {
+	Security
+}
+
+func (a *Anonymous) Authorize(c *gin.Context) {
+}
+func (a *Anonymous) Provider() string {
+	return ""
+}
+
+func (a *Anonymous) Scheme() *openapi3.SecurityScheme {
+	return nil
+}
+
+func (a *Anonymous) Requirements() [][]ISecurity {
+	return [][]ISecurity{{}}
+}
+
+// rejected reports whether a security rejected the request of c. The
+// securities tried by AnyOf run on a copy of the request context, aborted
+// from the start, so they are rejected by writing a response.
+func rejected(c *gin.Context) bool {
+	if rec, ok := c.Writer.(*recorder); ok {
+		return rec.written
+	}
+	return c.IsAborted()
+}
+
+// recorder records the response of a security tried by AnyOf, instead of
+// writing it to the response of the request.
+type recorder struct {
+	gin.ResponseWriter
+	header  http.Header
+	status  int
+	body    bytes.Buffer
+	written bool
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	r.written = true
+	return r.body.Write(data)
+}
+
+func (r *recorder) WriteString(s string) (int, error) {
+	return r.Write([]byte(s))
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) WriteHeaderNow() {
+	r.written = true
+}
+
+func (r *recorder) Status() int {
+	return r.status
+}
+
+func (r *recorder) Size() int {
+	if !r.written {
+		return -1
+	}
+	return r.body.Len()
+}
+
+func (r *recorder) Written() bool {
+	return r.written
+}
@@ -0,0 +1,150 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestAnyOf(t *testing.T) {
+	secret := []byte("secret")
+	failingKey := &ApiKey{Name: "X-Key", Validator: func(c *gin.Context, key string) (any, error) {
+		c.Set("leaked", true)
+		c.Header("X-Failed", "key")
+		return nil, ErrInvalidKey
+	}}
+	reader := "Bearer " + sign(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"scope": "read"})
+	tests := []struct {
+		name           string
+		security       ISecurity
+		header         http.Header
+		wantCode       int
+		wantChallenges []string
+		wantAuthorized string
+	}{
+		{
+			name:           "second authorizes",
+			security:       Any(failingKey, &JWT{Key: secret}),
+			header:         http.Header{"X-Key": {"key"}, "Authorization": {reader}},
+			wantCode:       http.StatusOK,
+			wantAuthorized: BearerAuth,
+		},
+		{
+			name:           "all fail",
+			security:       Any(&Basic{}, &JWT{Key: secret}),
+			wantCode:       http.StatusUnauthorized,
+			wantChallenges: []string{`Basic realm="Restricted", charset="UTF-8"`, "Bearer"},
+		},
+		{
+			name:           "forbidden first",
+			security:       Any(failingKey, RequireScopes(&JWT{Key: secret}, "write")),
+			header:         http.Header{"X-Key": {"key"}, "Authorization": {reader}},
+			wantCode:       http.StatusForbidden,
+			wantChallenges: []string{`Bearer error="insufficient_scope", scope="write"`},
+		},
+		{
+			name:     "optional",
+			security: Optional(&JWT{Key: secret}),
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var order []string
+			engine := gin.New()
+			engine.GET("/", func(c *gin.Context) {
+				order = append(order, "before")
+				c.Next()
+				order = append(order, "after")
+			}, tt.security.Authorize, func(c *gin.Context) {
+				order = append(order, "api")
+				if c.GetBool("leaked") {
+					t.Error("keys of a failed security are kept")
+				}
+				if len(c.Errors) != 0 {
+					t.Errorf("errors of a failed security are kept: %v", c.Errors)
+				}
+				if _, ok := GetAuthorized(c)[tt.wantAuthorized]; tt.wantAuthorized != "" && !ok {
+					t.Errorf("authorized = %v, want %s", GetAuthorized(c), tt.wantAuthorized)
+				}
+				c.Status(http.StatusOK)
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.header {
+				r.Header[k] = v
+			}
+			engine.ServeHTTP(w, r)
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if got := w.Header().Values("WWW-Authenticate"); !slices.Equal(got, tt.wantChallenges) {
+				t.Errorf("challenges = %q, want %q", got, tt.wantChallenges)
+			}
+			if tt.wantCode == http.StatusOK && w.Header().Get("X-Failed") != "" {
+				t.Error("headers of a failed security are sent")
+			}
+			wantOrder := []string{"before", "after"}
+			if tt.wantCode == http.StatusOK {
+				wantOrder = []string{"before", "api", "after"}
+			}
+			if !slices.Equal(order, wantOrder) {
+				t.Errorf("order = %v, want %v", order, wantOrder)
+			}
+		})
+	}
+}
+
+func TestAnyOfNested(t *testing.T) {
+	failing := &ApiKey{Name: "X-Key", Validator: func(c *gin.Context, key string) (any, error) {
+		_ = c.Error(errors.New("invalid key"))
+		return nil, ErrInvalidKey
+	}}
+	accepting := &ApiKey{Security: Security{SchemeName: "Other"}, Name: "X-Other"}
+	w := authorize(t, Any(Any(failing, failing), All(failing), accepting), "")
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("status without keys = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	engine := gin.New()
+	engine.GET("/", Any(Any(failing, failing), All(failing), accepting).Authorize, func(c *gin.Context) {
+		c.String(http.StatusOK, "%v", GetAuthorized(c)["Other"])
+	})
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Key", "key")
+	r.Header.Set("X-Other", "other")
+	engine.ServeHTTP(rec, r)
+	if rec.Code != http.StatusOK || rec.Body.String() != "other" {
+		t.Errorf("response = %d %q, want %d %q", rec.Code, rec.Body.String(), http.StatusOK, "other")
+	}
+}
+
+func TestAnyOfKeepsSuccess(t *testing.T) {
+	type ctxKey struct{}
+	accepting := &ApiKey{Name: "X-Key", Validator: func(c *gin.Context, key string) (any, error) {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey{}, key))
+		c.Header("X-Passed", key)
+		return key, nil
+	}}
+	engine := gin.New()
+	engine.GET("/", Any(&Basic{}, accepting).Authorize, func(c *gin.Context) {
+		c.String(http.StatusOK, "%v %v", c.Request.Context().Value(ctxKey{}), c.Value(Credentials))
+	})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Key", "key")
+	engine.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || w.Body.String() != "key key" || w.Header().Get("X-Passed") != "key" {
+		t.Errorf("response = %d %q %v, want %d %q with X-Passed", w.Code, w.Body.String(), w.Header(), http.StatusOK, "key key")
+	}
+	if challenges := w.Header().Values("WWW-Authenticate"); len(challenges) != 0 {
+		t.Errorf("challenges of the failed security are sent: %q", challenges)
+	}
+}
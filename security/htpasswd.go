@@ -0,0 +1,112 @@
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// htpasswdCheckInterval is how often an htpasswd file is checked for
+// changes.
+const htpasswdCheckInterval = time.Second
+
+// unknownUserHash is compared to the passwords of unknown users, so they take
+// as long to reject as the users of the file.
+var unknownUserHash = []byte("$2a$10$.LzvmQe.pu4GZ9gH7pVVsumC68DFNxmflYfyadgJOlz1sErLnUHam")
+
+type htpasswd struct {
+	file string
+
+	mu      sync.Mutex
+	users   map[string]string
+	modTime time.Time
+	size    int64
+	checked time.Time
+}
+
+// Htpasswd accepts the users of an htpasswd file with bcrypt or {SHA}
+// passwords, the file is reloaded when it changes. It panics when the file
+// can't be loaded.
+func Htpasswd(file string) BasicVerifier {
+	h := &htpasswd{file: file}
+	if err := h.load(); err != nil {
+		panic("security: " + err.Error())
+	}
+	return h.verify
+}
+
+func (h *htpasswd) verify(c *gin.Context, username, password string) (any, error) {
+	hash, ok := h.lookup(username)
+	if !ok {
+		_ = bcrypt.CompareHashAndPassword(unknownUserHash, []byte(password))
+		return nil, ErrInvalidPassword
+	}
+	if strings.HasPrefix(hash, "{SHA}") {
+		sum := sha1.Sum([]byte(password))
+		if subtle.ConstantTimeCompare([]byte(hash[len("{SHA}"):]), []byte(base64.StdEncoding.EncodeToString(sum[:]))) != 1 {
+			return nil, ErrInvalidPassword
+		}
+	} else if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		return nil, ErrInvalidPassword
+	}
+	return &User{Username: username}, nil
+}
+
+// lookup returns the password hash of username, reloading the file when it
+// changed, the current users are kept when reloading fails.
+func (h *htpasswd) lookup(username string) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if now := time.Now(); now.Sub(h.checked) >= htpasswdCheckInterval {
+		h.checked = now
+		if info, err := os.Stat(h.file); err == nil && (!info.ModTime().Equal(h.modTime) || info.Size() != h.size) {
+			if err = h.load(); err != nil {
+				// don't reload until it changes again
+				h.modTime, h.size = info.ModTime(), info.Size()
+				log.Printf("security: %v", err)
+			}
+		}
+	}
+	hash, ok := h.users[username]
+	return hash, ok
+}
+
+func (h *htpasswd) load() error {
+	info, err := os.Stat(h.file)
+	if err != nil {
+		return fmt.Errorf("load htpasswd: %w", err)
+	}
+	data, err := os.ReadFile(h.file)
+	if err != nil {
+		return fmt.Errorf("load htpasswd: %w", err)
+	}
+	users := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		username, hash, ok := strings.Cut(text, ":")
+		if !ok || username == "" {
+			return fmt.Errorf("load htpasswd %s:%d: invalid entry", h.file, line)
+		}
+		if !strings.HasPrefix(hash, "{SHA}") && !strings.HasPrefix(hash, "$2") {
+			return fmt.Errorf("load htpasswd %s:%d: unsupported password hash of %q, use bcrypt or {SHA}", h.file, line, username)
+		}
+		users[username] = hash
+	}
+	h.users, h.modTime, h.size = users, info.ModTime(), info.Size()
+	return nil
+}
@@ -0,0 +1,131 @@
+package security
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// writeHtpasswd writes the entries to file, moving its modification time
+// forward so the change is noticed.
+func writeHtpasswd(t *testing.T, file string, entries ...string) {
+	t.Helper()
+	modTime := time.Now()
+	if info, err := os.Stat(file); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+	if err := os.WriteFile(file, []byte(strings.Join(entries, "\n")+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func bcryptEntry(t *testing.T, username, password string) string {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return username + ":" + string(hash)
+}
+
+func shaEntry(username, password string) string {
+	sum := sha1.Sum([]byte(password))
+	return username + ":{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestHtpasswd(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".htpasswd")
+	writeHtpasswd(t, file, "# users", bcryptEntry(t, "alice", "secret"), "", shaEntry("bob", "password"))
+	basic := &Basic{Realm: "Admin", Verifier: Htpasswd(file)}
+	tests := []struct {
+		name, username, password string
+		wantCode                 int
+	}{
+		{"bcrypt", "alice", "secret", http.StatusOK},
+		{"bcrypt wrong password", "alice", "password", http.StatusUnauthorized},
+		{"sha", "bob", "password", http.StatusOK},
+		{"sha wrong password", "bob", "secret", http.StatusUnauthorized},
+		{"unknown user", "carol", "secret", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, basic, basicAuth(tt.username, tt.password))
+			if w.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if want := `Basic realm="Admin", charset="UTF-8"`; tt.wantCode == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != want {
+				t.Errorf("challenge = %q, want %q", w.Header().Get("WWW-Authenticate"), want)
+			}
+		})
+	}
+}
+
+func TestHtpasswdInvalid(t *testing.T) {
+	dir := t.TempDir()
+	for name, entries := range map[string][]string{
+		"no hash":     {"alice"},
+		"plain text":  {"alice:secret"},
+		"no username": {":{SHA}x"},
+	} {
+		file := filepath.Join(dir, strings.ReplaceAll(name, " ", "_"))
+		writeHtpasswd(t, file, entries...)
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Htpasswd() with %s didn't panic", name)
+				}
+			}()
+			Htpasswd(file)
+		}()
+	}
+}
+
+func TestHtpasswdReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".htpasswd")
+	writeHtpasswd(t, file, shaEntry("alice", "secret"))
+	h := &htpasswd{file: file}
+	if err := h.load(); err != nil {
+		t.Fatal(err)
+	}
+	verify := func(username, password string) error {
+		// check the file on every call rather than once a second
+		h.mu.Lock()
+		h.checked = time.Time{}
+		h.mu.Unlock()
+		_, err := h.verify(nil, username, password)
+		return err
+	}
+	if err := verify("alice", "secret"); err != nil {
+		t.Fatalf("alice before reload: %v", err)
+	}
+
+	writeHtpasswd(t, file, shaEntry("alice", "changed"), bcryptEntry(t, "bob", "secret"))
+	if err := verify("alice", "secret"); !errors.Is(err, ErrInvalidPassword) {
+		t.Errorf("alice with the old password after reload = %v, want %v", err, ErrInvalidPassword)
+	}
+	for username, password := range map[string]string{"alice": "changed", "bob": "secret"} {
+		if err := verify(username, password); err != nil {
+			t.Errorf("%s after reload: %v", username, err)
+		}
+	}
+
+	// an invalid file is rejected, the previous users are kept
+	writeHtpasswd(t, file, shaEntry("carol", "secret"), "dave:plain")
+	if err := verify("carol", "secret"); !errors.Is(err, ErrInvalidPassword) {
+		t.Errorf("carol of the rejected file = %v, want %v", err, ErrInvalidPassword)
+	}
+	if err := verify("bob", "secret"); err != nil {
+		t.Errorf("bob after the rejected reload: %v", err)
+	}
+}
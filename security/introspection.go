@@ -0,0 +1,123 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Introspection validates access tokens with the RFC 7662 introspection
+// endpoint of an authorization server.
+type Introspection struct {
+	URL string
+	// ClientID and ClientSecret authenticate to the endpoint with basic auth.
+	ClientID     string
+	ClientSecret string
+	// Client calls the endpoint, http.DefaultClient when nil.
+	Client *http.Client
+	// CacheTTL caches the responses for active tokens, never past the
+	// expiry of the token, no caching when zero.
+	CacheTTL time.Duration
+
+	mu      sync.Mutex
+	cache   map[[sha256.Size]byte]cachedIntrospection
+	sweepAt int
+}
+
+type cachedIntrospection struct {
+	response *IntrospectionResponse
+	expires  time.Time
+}
+
+// IntrospectionResponse is the response of an introspection endpoint.
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Nbf       int64  `json:"nbf,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Aud       any    `json:"aud,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+// Introspect returns the introspection response of an active token, or an
+// error for an inactive one.
+func (i *Introspection) Introspect(ctx context.Context, token string) (*IntrospectionResponse, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	i.mu.Lock()
+	cached, ok := i.cache[key]
+	i.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.response, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.URL, strings.NewReader(url.Values{
+		"token":           {token},
+		"token_type_hint": {"access_token"},
+	}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if i.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(i.ClientID), url.QueryEscape(i.ClientSecret))
+	}
+	client := i.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspect: %s", resp.Status)
+	}
+	response := &IntrospectionResponse{}
+	if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+	if !response.Active {
+		return nil, fmt.Errorf("introspect: inactive token")
+	}
+	if response.Exp != 0 && !now.Before(time.Unix(response.Exp, 0)) {
+		return nil, fmt.Errorf("introspect: expired token")
+	}
+
+	if i.CacheTTL > 0 {
+		expires := now.Add(i.CacheTTL)
+		if response.Exp != 0 && time.Unix(response.Exp, 0).Before(expires) {
+			expires = time.Unix(response.Exp, 0)
+		}
+		i.mu.Lock()
+		if i.cache == nil {
+			i.cache = make(map[[sha256.Size]byte]cachedIntrospection)
+		}
+		if len(i.cache) >= i.sweepAt {
+			for k, v := range i.cache {
+				if !now.Before(v.expires) {
+					delete(i.cache, k)
+				}
+			}
+			i.sweepAt = 2*len(i.cache) + 64
+		}
+		i.cache[key] = cachedIntrospection{response: response, expires: expires}
+		i.mu.Unlock()
+	}
+	return response, nil
+}
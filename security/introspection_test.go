@@ -0,0 +1,151 @@
+package security
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// newIntrospectionServer serves the introspection responses of tokens,
+// inactive for other tokens, counting the requests.
+func newIntrospectionServer(t *testing.T, responses map[string]*IntrospectionResponse) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	requests := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if id, secret, _ := r.BasicAuth(); id != "api" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response, ok := responses[r.PostFormValue("token")]
+		if !ok {
+			response = &IntrospectionResponse{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestIntrospect(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+	server, _ := newIntrospectionServer(t, map[string]*IntrospectionResponse{
+		"active":  {Active: true, Scope: "read write", Sub: "alice", Exp: exp},
+		"expired": {Active: true, Exp: time.Now().Add(-time.Minute).Unix()},
+	})
+	i := &Introspection{URL: server.URL, ClientID: "api", ClientSecret: "secret"}
+	tests := []struct {
+		token   string
+		wantSub string
+		wantErr bool
+	}{
+		{token: "active", wantSub: "alice"},
+		{token: "inactive", wantErr: true},
+		{token: "expired", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			response, err := i.Introspect(t.Context(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Introspect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && response.Sub != tt.wantSub {
+				t.Errorf("sub = %q, want %q", response.Sub, tt.wantSub)
+			}
+		})
+	}
+
+	i.ClientSecret = "wrong"
+	if _, err := i.Introspect(t.Context(), "active"); err == nil {
+		t.Error("Introspect() with a wrong client secret = nil, want an error")
+	}
+}
+
+func TestIntrospectCache(t *testing.T) {
+	soon := time.Now().Add(time.Minute).Unix()
+	server, requests := newIntrospectionServer(t, map[string]*IntrospectionResponse{
+		"active":     {Active: true},
+		"expiring":   {Active: true, Exp: soon},
+		"inactive":   {},
+		"long-lived": {Active: true, Exp: time.Now().Add(24 * time.Hour).Unix()},
+	})
+	i := &Introspection{URL: server.URL, ClientID: "api", ClientSecret: "secret", CacheTTL: time.Hour}
+
+	for range 3 {
+		if _, err := i.Introspect(t.Context(), "active"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests for a cached token = %d, want 1", got)
+	}
+
+	for range 2 {
+		if _, err := i.Introspect(t.Context(), "inactive"); err == nil {
+			t.Fatal("Introspect() inactive = nil, want an error")
+		}
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("requests with an inactive token = %d, want 3, inactive tokens aren't cached", got)
+	}
+
+	before := time.Now()
+	for _, token := range []string{"expiring", "long-lived"} {
+		if _, err := i.Introspect(t.Context(), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expires := i.cache[sha256.Sum256([]byte("expiring"))].expires; !expires.Equal(time.Unix(soon, 0)) {
+		t.Errorf("cache of a token expiring before the ttl expires at %v, want its exp %v", expires, time.Unix(soon, 0))
+	}
+	if expires := i.cache[sha256.Sum256([]byte("long-lived"))].expires; expires.Before(before.Add(time.Hour)) || expires.After(time.Now().Add(time.Hour)) {
+		t.Errorf("cache of a token expiring after the ttl expires at %v, want the ttl", expires)
+	}
+
+	// an expired entry is introspected again
+	key := sha256.Sum256([]byte("active"))
+	i.cache[key] = cachedIntrospection{response: i.cache[key].response, expires: time.Now().Add(-time.Second)}
+	count := requests.Load()
+	if _, err := i.Introspect(t.Context(), "active"); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != count+1 {
+		t.Errorf("requests after the cache expired = %d, want %d", got, count+1)
+	}
+}
+
+func TestOAuth2Introspection(t *testing.T) {
+	server, _ := newIntrospectionServer(t, map[string]*IntrospectionResponse{
+		"reader": {Active: true, Scope: "read"},
+		"writer": {Active: true, Scope: "read write"},
+	})
+	o := &OAuth2{
+		RequiredScopes: []string{"write"},
+		Introspection:  &Introspection{URL: server.URL, ClientID: "api", ClientSecret: "secret"},
+	}
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      int
+		wantChallenge string
+	}{
+		{"scope granted", "Bearer writer", http.StatusOK, ""},
+		{"scope missing", "Bearer reader", http.StatusForbidden, `Bearer error="insufficient_scope", scope="write"`},
+		{"inactive", "Bearer unknown", http.StatusUnauthorized, `Bearer error="invalid_token"`},
+		{"no token", "", http.StatusUnauthorized, "Bearer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, o, tt.authorization)
+			if w.Code != tt.wantCode || w.Header().Get("WWW-Authenticate") != tt.wantChallenge {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Header().Get("WWW-Authenticate"), tt.wantCode, tt.wantChallenge)
+			}
+		})
+	}
+}
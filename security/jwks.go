@@ -0,0 +1,276 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Default refresh intervals and fetch timeout of JWKS and OpenID.
+const (
+	DefaultRefreshInterval    = time.Hour
+	DefaultMinRefreshInterval = time.Minute
+	DefaultFetchTimeout       = 10 * time.Second
+)
+
+// JWKS is a JSON Web Key Set read from a local file or an url, loaded on
+// first use and reloaded periodically. A token signed with an unknown key
+// reloads the keys too, so rotated keys are picked up, at most once every
+// MinRefreshInterval. Requests arriving during a reload wait for it instead
+// of loading the keys again.
+type JWKS struct {
+	File string
+	URL  string
+	// Client fetches URL, http.DefaultClient when nil. Fetching gives up
+	// after DefaultFetchTimeout.
+	Client *http.Client
+	// RefreshInterval reloads the keys, DefaultRefreshInterval when zero.
+	RefreshInterval time.Duration
+	// MinRefreshInterval limits reloads, DefaultMinRefreshInterval when zero.
+	MinRefreshInterval time.Duration
+
+	mu      sync.Mutex
+	keys    map[string]any
+	all     []jwt.VerificationKey
+	loaded  time.Time
+	tried   time.Time
+	lastErr error
+	// loading is closed once the reload in progress is done.
+	loading chan struct{}
+}
+
+// JWK is a JSON Web Key, only the members of public and symmetric keys are
+// supported.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	K   string `json:"k,omitempty"`
+}
+
+// Key returns the key with id kid, or every key when kid is empty.
+func (s *JWKS) Key(kid string) (any, error) {
+	now := time.Now()
+	keys, all, loaded, err := s.current()
+	if keys == nil || now.Sub(loaded) >= orDefault(s.RefreshInterval, DefaultRefreshInterval) {
+		s.refresh(now)
+		keys, all, _, err = s.current()
+	}
+	if keys == nil {
+		return nil, err
+	}
+	if kid == "" {
+		return jwt.VerificationKeySet{Keys: all}, nil
+	}
+	key, ok := keys[kid]
+	if !ok {
+		s.refresh(now)
+		keys, _, _, _ = s.current()
+		if key, ok = keys[kid]; !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+	}
+	return key, nil
+}
+
+// current returns the loaded keys, which are replaced rather than modified
+// by reloads.
+func (s *JWKS) current() (map[string]any, []jwt.VerificationKey, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keys, s.all, s.loaded, s.lastErr
+}
+
+// refresh reloads the keys unless they were tried within MinRefreshInterval,
+// keeping the current keys when reloading fails. It waits for a reload in
+// progress rather than starting another one, and loads without holding mu.
+func (s *JWKS) refresh(now time.Time) {
+	s.mu.Lock()
+	if loading := s.loading; loading != nil {
+		s.mu.Unlock()
+		<-loading
+		return
+	}
+	if !s.tried.IsZero() && now.Sub(s.tried) < orDefault(s.MinRefreshInterval, DefaultMinRefreshInterval) {
+		s.mu.Unlock()
+		return
+	}
+	s.tried = now
+	loading := make(chan struct{})
+	s.loading = loading
+	s.mu.Unlock()
+
+	keys, all, err := s.load()
+
+	s.mu.Lock()
+	if s.lastErr = err; err == nil {
+		s.keys, s.all, s.loaded = keys, all, now
+	}
+	s.loading = nil
+	s.mu.Unlock()
+	close(loading)
+}
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
+
+func (s *JWKS) load() (map[string]any, []jwt.VerificationKey, error) {
+	var data []byte
+	var err error
+	if s.File != "" {
+		data, err = os.ReadFile(s.File)
+	} else if s.URL != "" {
+		data, err = fetch(s.Client, s.URL)
+	} else {
+		err = errors.New("no file or url")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("load jwks: %w", err)
+	}
+	jwks, err := ParseJWKS(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make(map[string]any, len(jwks))
+	var all []jwt.VerificationKey
+	for _, jwk := range jwks {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		if key == nil {
+			continue
+		}
+		if jwk.Kid != "" {
+			keys[jwk.Kid] = key
+		}
+		all = append(all, key)
+	}
+	return keys, all, nil
+}
+
+// fetch returns the body of a GET of url with client, http.DefaultClient
+// when nil, giving up after DefaultFetchTimeout.
+func fetch(client *http.Client, url string) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// ParseJWKS parses the keys of a JSON Web Key Set.
+func ParseJWKS(data []byte) ([]*JWK, error) {
+	var set struct {
+		Keys []*JWK `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+	return set.Keys, nil
+}
+
+// PublicKey returns the key verifying signatures: an *rsa.PublicKey, an
+// *ecdsa.PublicKey, an ed25519.PublicKey or a []byte secret, or nil for
+// encryption and unsupported keys.
+func (k *JWK) PublicKey() (any, error) {
+	if k.Use == "enc" {
+		return nil, nil
+	}
+	decode := func(name, value string) ([]byte, error) {
+		b, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil || len(b) == 0 {
+			return nil, fmt.Errorf("jwk %q: invalid %s", k.Kid, name)
+		}
+		return b, nil
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := decode("n", k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode("e", k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode("y", k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("jwk %q: point is not on curve %s", k.Kid, k.Crv)
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, nil
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("jwk %q: invalid x", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	case "oct":
+		return decode("k", k.K)
+	}
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultAlgorithms are the signing algorithms JWT accepts by default.
+var DefaultAlgorithms = []string{"HS256", "RS256", "ES256", "EdDSA"}
+
+// JWT is a Bearer verifying JSON Web Tokens, the claims of a valid token
+// are stored as the credentials.
+type JWT struct {
+	Bearer
+	// Key verifies the tokens: a []byte secret, an *rsa.PublicKey, an
+	// *ecdsa.PublicKey or an ed25519.PublicKey.
+	Key any
+	// JWKS verifies the tokens with the key matching their kid, used when
+	// Key is nil.
+	JWKS *JWKS
+	// Algorithms accepted, DefaultAlgorithms when empty.
+	Algorithms []string
+	// Issuer is the required iss claim, unchecked when empty.
+	Issuer string
+	// Audience is the required aud claim, unchecked when empty.
+	Audience string
+	// Leeway is the clock skew allowed when checking exp, nbf and iat.
+	Leeway time.Duration
+	// NewClaims returns the claims a token is parsed into, like
+	// &MyClaims{}, jwt.MapClaims by default.
+	NewClaims func() jwt.Claims
+}
+
+func (j *JWT) Authorize(c *gin.Context) {
+	token, ok := bearerToken(c)
+	if !ok {
+		bearerCallback(c, j, nil, errNoToken, nil)
+		return
+	}
+	claims, err := j.Verify(token)
+	bearerCallback(c, j, claims, err, nil)
+}
+
+// Verify parses token and checks its signature and claims.
+func (j *JWT) Verify(token string) (jwt.Claims, error) {
+	claims := jwt.Claims(jwt.MapClaims{})
+	if j.NewClaims != nil {
+		claims = j.NewClaims()
+	}
+	algorithms := j.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = DefaultAlgorithms
+	}
+	options := []jwt.ParserOption{jwt.WithValidMethods(algorithms), jwt.WithLeeway(j.Leeway), jwt.WithIssuedAt()}
+	if j.Issuer != "" {
+		options = append(options, jwt.WithIssuer(j.Issuer))
+	}
+	if j.Audience != "" {
+		options = append(options, jwt.WithAudience(j.Audience))
+	}
+	if _, err := jwt.ParseWithClaims(token, claims, j.key, options...); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (j *JWT) key(token *jwt.Token) (any, error) {
+	if j.Key != nil {
+		return j.Key, nil
+	}
+	if j.JWKS != nil {
+		kid, _ := token.Header["kid"].(string)
+		return j.JWKS.Key(kid)
+	}
+	return nil, errors.New("no verification key")
+}
+
+// errNoToken is the error of a request without a bearer token.
+var errNoToken = errors.New("empty authentication")
+
+// bearerCallback calls the Callback of s with credentials, or with err and
+// the challenge RFC 6750 describes for it: a missing token, a token lacking
+// scopes, or an invalid token.
+func bearerCallback(c *gin.Context, s ISecurity, credentials any, err error, scopes []string) {
+	switch {
+	case err == nil:
+		s.Callback(c, credentials, nil)
+		return
+	case errors.Is(err, errNoToken):
+		c.Header("WWW-Authenticate", "Bearer")
+	case errors.Is(err, ErrInsufficientScope):
+		c.Header("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope=%q`, strings.Join(scopes, " ")))
+	default:
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+	}
+	s.Callback(c, nil, err)
+}
+
+// bearerToken returns the token of the Authorization header.
+func bearerToken(c *gin.Context) (string, bool) {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,273 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// authorize serves a request with the Authorization header authorization
+// behind s, returning the response.
+func authorize(t *testing.T, s ISecurity, authorization string) *httptest.ResponseRecorder {
+	t.Helper()
+	engine := gin.New()
+	engine.GET("/", s.Authorize, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		r.Header.Set("Authorization", authorization)
+	}
+	engine.ServeHTTP(w, r)
+	return w
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// rsaJWK returns the public JWK of key.
+func rsaJWK(kid string, key *rsa.PrivateKey) *JWK {
+	return &JWK{
+		Kty: "RSA",
+		Kid: kid,
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// jwksServer serves the keys it holds as a JSON Web Key Set, counting the
+// requests.
+type jwksServer struct {
+	*httptest.Server
+	keys     atomic.Pointer[[]*JWK]
+	requests atomic.Int32
+}
+
+func newJWKSServer(t *testing.T, keys ...*JWK) *jwksServer {
+	t.Helper()
+	s := &jwksServer{}
+	s.setKeys(keys...)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": *s.keys.Load()})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// setKeys rotates the keys served.
+func (s *jwksServer) setKeys(keys ...*JWK) {
+	s.keys.Store(&keys)
+}
+
+func TestJWTAlgorithms(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	rsaKey := newRSAKey(t)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		method jwt.SigningMethod
+		sign   crypto.PrivateKey
+		verify any
+	}{
+		{"HS256", jwt.SigningMethodHS256, secret, secret},
+		{"RS256", jwt.SigningMethodRS256, rsaKey, &rsaKey.PublicKey},
+		{"ES256", jwt.SigningMethodES256, ecKey, &ecKey.PublicKey},
+		{"EdDSA", jwt.SigningMethodEdDSA, edKey, edPublic},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, tt.method, tt.sign, "", jwt.MapClaims{"sub": "alice"})
+			j := &JWT{Key: tt.verify}
+			claims, err := j.Verify(token)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if sub, _ := claims.GetSubject(); sub != "alice" {
+				t.Errorf("sub = %q, want alice", sub)
+			}
+			j.Algorithms = []string{"PS256"}
+			if _, err = j.Verify(token); err == nil {
+				t.Errorf("Verify() with %s not accepted = nil, want an error", tt.name)
+			}
+		})
+	}
+}
+
+func TestJWTClaims(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	tests := []struct {
+		name    string
+		jwt     JWT
+		claims  jwt.MapClaims
+		wantErr bool
+	}{
+		{name: "valid", claims: jwt.MapClaims{"exp": now.Add(time.Minute).Unix()}},
+		{name: "expired", claims: jwt.MapClaims{"exp": now.Add(-10 * time.Second).Unix()}, wantErr: true},
+		{name: "expired within leeway", jwt: JWT{Leeway: time.Minute}, claims: jwt.MapClaims{"exp": now.Add(-10 * time.Second).Unix()}},
+		{name: "not before", claims: jwt.MapClaims{"nbf": now.Add(10 * time.Second).Unix()}, wantErr: true},
+		{name: "not before within leeway", jwt: JWT{Leeway: time.Minute}, claims: jwt.MapClaims{"nbf": now.Add(10 * time.Second).Unix()}},
+		{name: "issuer", jwt: JWT{Issuer: "https://issuer"}, claims: jwt.MapClaims{"iss": "https://issuer"}},
+		{name: "other issuer", jwt: JWT{Issuer: "https://issuer"}, claims: jwt.MapClaims{"iss": "https://other"}, wantErr: true},
+		{name: "no issuer", jwt: JWT{Issuer: "https://issuer"}, claims: jwt.MapClaims{}, wantErr: true},
+		{name: "audience", jwt: JWT{Audience: "api"}, claims: jwt.MapClaims{"aud": []string{"web", "api"}}},
+		{name: "other audience", jwt: JWT{Audience: "api"}, claims: jwt.MapClaims{"aud": "web"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := tt.jwt
+			j.Key = secret
+			_, err := j.Verify(sign(t, jwt.SigningMethodHS256, secret, "", tt.claims))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWTAuthorize(t *testing.T) {
+	secret := []byte("secret")
+	j := &JWT{Key: secret}
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      int
+		wantChallenge string
+	}{
+		{"valid", "Bearer " + sign(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{}), http.StatusOK, ""},
+		{"missing", "", http.StatusUnauthorized, "Bearer"},
+		{"invalid", "Bearer " + sign(t, jwt.SigningMethodHS256, []byte("other"), "", jwt.MapClaims{}), http.StatusUnauthorized, `Bearer error="invalid_token"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, j, tt.authorization)
+			if w.Code != tt.wantCode || w.Header().Get("WWW-Authenticate") != tt.wantChallenge {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Header().Get("WWW-Authenticate"), tt.wantCode, tt.wantChallenge)
+			}
+		})
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	key1, key2, unknown := newRSAKey(t), newRSAKey(t), newRSAKey(t)
+
+	t.Run("kid", func(t *testing.T) {
+		server := newJWKSServer(t, rsaJWK("1", key1), rsaJWK("2", key2))
+		j := &JWT{JWKS: &JWKS{URL: server.URL}}
+		if _, err := j.Verify(sign(t, jwt.SigningMethodRS256, key2, "2", jwt.MapClaims{})); err != nil {
+			t.Errorf("Verify() kid 2 error = %v", err)
+		}
+		if _, err := j.Verify(sign(t, jwt.SigningMethodRS256, key1, "2", jwt.MapClaims{})); err == nil {
+			t.Error("Verify() signed by key 1 with kid 2 = nil, want an error")
+		}
+	})
+
+	t.Run("no kid", func(t *testing.T) {
+		server := newJWKSServer(t, rsaJWK("", key1), rsaJWK("", key2))
+		j := &JWT{JWKS: &JWKS{URL: server.URL}}
+		for name, key := range map[string]*rsa.PrivateKey{"key 1": key1, "key 2": key2} {
+			if _, err := j.Verify(sign(t, jwt.SigningMethodRS256, key, "", jwt.MapClaims{})); err != nil {
+				t.Errorf("Verify() without kid signed by %s error = %v", name, err)
+			}
+		}
+		if _, err := j.Verify(sign(t, jwt.SigningMethodRS256, unknown, "", jwt.MapClaims{})); err == nil {
+			t.Error("Verify() without kid signed by an unknown key = nil, want an error")
+		}
+		if got := server.requests.Load(); got != 1 {
+			t.Errorf("jwks requests = %d, want 1", got)
+		}
+	})
+
+	t.Run("concurrent load", func(t *testing.T) {
+		var requests atomic.Int32
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			<-release
+			_ = json.NewEncoder(w).Encode(map[string]any{"keys": []*JWK{rsaJWK("1", key1)}})
+		}))
+		t.Cleanup(server.Close)
+		jwks := &JWKS{URL: server.URL}
+		var wg sync.WaitGroup
+		errs := make(chan error, 4)
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := jwks.Key("1")
+				errs <- err
+			}()
+		}
+		for deadline := time.Now().Add(5 * time.Second); requests.Load() == 0; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatal("the keys are never fetched")
+			}
+		}
+		// the lock isn't held while fetching
+		if !jwks.mu.TryLock() {
+			t.Error("the keys are fetched holding the lock")
+		} else {
+			jwks.mu.Unlock()
+		}
+		close(release)
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Errorf("Key() error = %v", err)
+			}
+		}
+		if got := requests.Load(); got != 1 {
+			t.Errorf("jwks requests = %d, want 1", got)
+		}
+	})
+}
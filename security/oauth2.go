@@ -1,35 +1,143 @@
 package security
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 )
 
+// OAuth2 flows of the docs.
+const (
+	FlowAuthorizationCode   = "authorizationCode"
+	FlowClientCredentials   = "clientCredentials"
+	FlowPassword            = "password"
+	FlowImplicit            = "implicit"
+	FlowDeviceAuthorization = "deviceAuthorization"
+)
+
+// XDeviceAuthorization is the extension of the oauth2 flows holding the
+// device authorization flow, which OpenAPI 3.0 doesn't define.
+const XDeviceAuthorization = "x-deviceAuthorization"
+
 type OAuth2 struct {
 	Security
-	AuthorizationURL string
-	TokenURL         string
-	RefreshURL       string
-	Scopes           map[string]string
+	AuthorizationURL       string
+	TokenURL               string
+	RefreshURL             string
+	DeviceAuthorizationURL string
+	Scopes                 map[string]string
+	// Flows declared in the docs, FlowAuthorizationCode when empty.
+	Flows []string
+	// RequiredScopes must all be granted to the token.
+	RequiredScopes []string
+	// Introspection validates the access tokens with the authorization
+	// server, the response is stored as the credentials.
+	Introspection *Introspection
+	// JWT validates the access tokens locally when Introspection is nil,
+	// the claims are stored as the credentials.
+	JWT *JWT
 }
 
 func (i *OAuth2) Authorize(c *gin.Context) {
-
+	token, ok := bearerToken(c)
+	if !ok {
+		bearerCallback(c, i, nil, errNoToken, nil)
+		return
+	}
+	var credentials any
+	var scopes []string
+	var err error
+	switch {
+	case i.Introspection != nil:
+		var response *IntrospectionResponse
+		if response, err = i.Introspection.Introspect(c.Request.Context(), token); err == nil {
+			credentials, scopes = response, strings.Fields(response.Scope)
+		}
+	case i.JWT != nil:
+		if credentials, err = i.JWT.Verify(token); err == nil {
+			scopes = claimScopes(credentials)
+		}
+	default:
+		err = errors.New("no access token validation configured")
+	}
+	if err == nil {
+		err = requireScopes(i.RequiredScopes, scopes)
+	}
+	bearerCallback(c, i, credentials, err, i.RequiredScopes)
 }
 func (i *OAuth2) Provider() string {
-	return OAuth2Auth
+	return i.provider(OAuth2Auth)
 }
 
 func (i *OAuth2) Scheme() *openapi3.SecurityScheme {
+	scopes := i.Scopes
+	if scopes == nil {
+		scopes = map[string]string{}
+	}
+	flows := &openapi3.OAuthFlows{}
+	for _, flow := range i.flows() {
+		switch flow {
+		case FlowAuthorizationCode:
+			flows.AuthorizationCode = &openapi3.OAuthFlow{AuthorizationURL: i.AuthorizationURL, TokenURL: i.TokenURL, RefreshURL: i.RefreshURL, Scopes: scopes}
+		case FlowClientCredentials:
+			flows.ClientCredentials = &openapi3.OAuthFlow{TokenURL: i.TokenURL, RefreshURL: i.RefreshURL, Scopes: scopes}
+		case FlowPassword:
+			flows.Password = &openapi3.OAuthFlow{TokenURL: i.TokenURL, RefreshURL: i.RefreshURL, Scopes: scopes}
+		case FlowImplicit:
+			flows.Implicit = &openapi3.OAuthFlow{AuthorizationURL: i.AuthorizationURL, RefreshURL: i.RefreshURL, Scopes: scopes}
+		case FlowDeviceAuthorization:
+			flows.Extensions = map[string]any{XDeviceAuthorization: map[string]any{
+				"deviceAuthorizationUrl": i.DeviceAuthorizationURL,
+				"tokenUrl":               i.TokenURL,
+				"scopes":                 scopes,
+			}}
+		}
+	}
 	return &openapi3.SecurityScheme{
-		Type: "oauth2",
-		Flows: &openapi3.OAuthFlows{
-			AuthorizationCode: &openapi3.OAuthFlow{
-				AuthorizationURL: i.AuthorizationURL,
-				TokenURL:         i.TokenURL,
-				RefreshURL:       i.RefreshURL,
-				Scopes:           i.Scopes,
-			},
-		},
+		Type:        "oauth2",
+		Flows:       flows,
+		Description: i.Description,
 	}
 }
+
+// GetRequiredScopes returns the scopes listed in the security requirements
+// of the docs.
+func (i *OAuth2) GetRequiredScopes() []string {
+	return i.RequiredScopes
+}
+
+func (i *OAuth2) flows() []string {
+	if len(i.Flows) == 0 {
+		return []string{FlowAuthorizationCode}
+	}
+	return i.Flows
+}
+
+// requireScopes returns an ErrInsufficientScope error when granted lacks
+// some of the required scopes.
+func requireScopes(required, granted []string) error {
+	if missing := missingScopes(required, granted); len(missing) != 0 {
+		return fmt.Errorf("%w: %s", ErrInsufficientScope, strings.Join(missing, " "))
+	}
+	return nil
+}
+
+// missingScopes returns the required scopes not in granted.
+func missingScopes(required, granted []string) []string {
+	var missing []string
+	for _, scope := range required {
+		if !slices.Contains(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// claimScopes returns the scopes of the scope and scp claims.
+func claimScopes(claims any) []string {
+	return append(claimStrings(claims, "scope"), claimStrings(claims, "scp")...)
+}
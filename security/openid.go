@@ -1,25 +1,196 @@
 package security
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
 )
 
+// OpenIDAlgorithms are the signing algorithms OpenID accepts by default,
+// keys published by a provider are asymmetric.
+var OpenIDAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+const wellKnownOpenID = "/.well-known/openid-configuration"
+
+// OpenID is a Bearer verifying the ID or access tokens of an OpenID Connect
+// provider, with the issuer and keys of its discovery document at
+// ConnectUrl. The document and the keys are fetched on first use and
+// refreshed periodically, the claims of a valid token are stored as the
+// credentials.
 type OpenID struct {
 	Security
 	ConnectUrl string
+	// ClientID is the required aud claim, unchecked when empty.
+	ClientID string
+	// RequiredScopes must all be granted to the token.
+	RequiredScopes []string
+	// Algorithms accepted, OpenIDAlgorithms when empty.
+	Algorithms []string
+	// Leeway is the clock skew allowed when checking exp, nbf and iat.
+	Leeway time.Duration
+	// NewClaims returns the claims a token is parsed into, jwt.MapClaims by
+	// default.
+	NewClaims func() jwt.Claims
+	// Client fetches the discovery document and the keys,
+	// http.DefaultClient when nil. Fetching gives up after
+	// DefaultFetchTimeout.
+	Client *http.Client
+	// RefreshInterval refetches the discovery document and the keys,
+	// DefaultRefreshInterval when zero.
+	RefreshInterval time.Duration
+	// MinRefreshInterval limits refetches, DefaultMinRefreshInterval when
+	// zero.
+	MinRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	discovery *Discovery
+	jwt       *JWT
+	loaded    time.Time
+	tried     time.Time
+	lastErr   error
+	// loading is closed once the fetch in progress is done.
+	loading chan struct{}
 }
 
-func (i *OpenID) Authorize(c *gin.Context) {
+// Discovery is the discovery document of an OpenID Connect provider.
+type Discovery struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	AuthorizationEndpoint            string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint                    string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint                 string   `json:"userinfo_endpoint,omitempty"`
+	IntrospectionEndpoint            string   `json:"introspection_endpoint,omitempty"`
+	ScopesSupported                  []string `json:"scopes_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
 
+func (i *OpenID) Authorize(c *gin.Context) {
+	token, ok := bearerToken(c)
+	if !ok {
+		bearerCallback(c, i, nil, errNoToken, nil)
+		return
+	}
+	claims, err := i.Verify(token)
+	if err == nil {
+		err = requireScopes(i.RequiredScopes, claimScopes(claims))
+	}
+	bearerCallback(c, i, claims, err, i.RequiredScopes)
 }
 func (i *OpenID) Provider() string {
-	return OpenIDAuth
+	return i.provider(OpenIDAuth)
 }
 
 func (i *OpenID) Scheme() *openapi3.SecurityScheme {
 	return &openapi3.SecurityScheme{
 		Type:             "openIdConnect",
 		OpenIdConnectUrl: i.ConnectUrl,
+		Description:      i.Description,
+	}
+}
+
+// GetRequiredScopes returns the scopes listed in the security requirements
+// of the docs.
+func (i *OpenID) GetRequiredScopes() []string {
+	return i.RequiredScopes
+}
+
+// Verify parses token and checks its signature with the keys of the
+// provider, its issuer and its claims.
+func (i *OpenID) Verify(token string) (jwt.Claims, error) {
+	verifier, err := i.verifier()
+	if err != nil {
+		return nil, err
+	}
+	return verifier.Verify(token)
+}
+
+// Discover returns the discovery document of the provider.
+func (i *OpenID) Discover() (*Discovery, error) {
+	if _, err := i.verifier(); err != nil {
+		return nil, err
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.discovery, nil
+}
+
+// verifier returns the JWT verifying the tokens of the current discovery
+// document, refetched once RefreshInterval has passed. The previous document
+// is kept when refetching fails. The document is fetched without holding mu,
+// concurrent calls wait for the fetch in progress.
+func (i *OpenID) verifier() (*JWT, error) {
+	now := time.Now()
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.jwt != nil && now.Sub(i.loaded) < orDefault(i.RefreshInterval, DefaultRefreshInterval) {
+		return i.jwt, nil
+	}
+	if loading := i.loading; loading != nil {
+		i.mu.Unlock()
+		<-loading
+		i.mu.Lock()
+	} else if i.tried.IsZero() || now.Sub(i.tried) >= orDefault(i.MinRefreshInterval, DefaultMinRefreshInterval) {
+		i.tried = now
+		loading = make(chan struct{})
+		i.loading = loading
+		i.mu.Unlock()
+		discovery, err := i.fetch()
+		i.mu.Lock()
+		i.loading = nil
+		close(loading)
+		if i.lastErr = err; err == nil {
+			i.loaded = now
+			if i.jwt == nil || i.jwt.JWKS.URL != discovery.JWKSURI {
+				i.jwt = &JWT{JWKS: &JWKS{
+					URL:                discovery.JWKSURI,
+					Client:             i.Client,
+					RefreshInterval:    i.RefreshInterval,
+					MinRefreshInterval: i.MinRefreshInterval,
+				}}
+			} else {
+				copied := *i.jwt
+				i.jwt = &copied
+			}
+			i.jwt.Issuer = discovery.Issuer
+			i.jwt.Audience = i.ClientID
+			i.jwt.Algorithms = i.Algorithms
+			if len(i.jwt.Algorithms) == 0 {
+				i.jwt.Algorithms = OpenIDAlgorithms
+			}
+			i.jwt.Leeway = i.Leeway
+			i.jwt.NewClaims = i.NewClaims
+			i.discovery = discovery
+		}
+	}
+	if i.jwt == nil {
+		return nil, i.lastErr
+	}
+	return i.jwt, nil
+}
+
+func (i *OpenID) fetch() (*Discovery, error) {
+	data, err := fetch(i.Client, i.ConnectUrl)
+	if err != nil {
+		return nil, fmt.Errorf("openid discovery: %w", err)
+	}
+	discovery := &Discovery{}
+	if err = json.Unmarshal(data, discovery); err != nil {
+		return nil, fmt.Errorf("openid discovery: %w", err)
+	}
+	if discovery.Issuer == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("openid discovery: missing issuer or jwks_uri")
+	}
+	// the issuer must be the one the document is published for
+	if base, ok := strings.CutSuffix(i.ConnectUrl, wellKnownOpenID); ok && strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(base, "/") {
+		return nil, fmt.Errorf("openid discovery: issuer %q doesn't match %s", discovery.Issuer, i.ConnectUrl)
 	}
+	return discovery, nil
 }
@@ -0,0 +1,160 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newDiscoveryServer serves a discovery document with the issuer, its own
+// url unless overridden, and the keys of jwksURI, counting the requests.
+func newDiscoveryServer(t *testing.T, issuer, jwksURI string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	requests := &atomic.Int32{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != wellKnownOpenID {
+			http.NotFound(w, r)
+			return
+		}
+		discovery := &Discovery{Issuer: issuer, JWKSURI: jwksURI}
+		if discovery.Issuer == "" {
+			discovery.Issuer = server.URL
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(discovery)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestOpenID(t *testing.T) {
+	key := newRSAKey(t)
+	keys := newJWKSServer(t, rsaJWK("1", key))
+	provider, _ := newDiscoveryServer(t, "", keys.URL)
+	o := &OpenID{ConnectUrl: provider.URL + wellKnownOpenID, ClientID: "api", RequiredScopes: []string{"read"}}
+	token := func(claims jwt.MapClaims) string {
+		claims["exp"] = time.Now().Add(time.Minute).Unix()
+		return "Bearer " + sign(t, jwt.SigningMethodRS256, key, "1", claims)
+	}
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      int
+		wantChallenge string
+	}{
+		{"valid", token(jwt.MapClaims{"iss": provider.URL, "aud": "api", "scope": "openid read"}), http.StatusOK, ""},
+		{"scope missing", token(jwt.MapClaims{"iss": provider.URL, "aud": "api", "scope": "openid"}), http.StatusForbidden, `Bearer error="insufficient_scope", scope="read"`},
+		{"other issuer", token(jwt.MapClaims{"iss": "https://other", "aud": "api", "scope": "read"}), http.StatusUnauthorized, `Bearer error="invalid_token"`},
+		{"other audience", token(jwt.MapClaims{"iss": provider.URL, "aud": "web", "scope": "read"}), http.StatusUnauthorized, `Bearer error="invalid_token"`},
+		{"no token", "", http.StatusUnauthorized, "Bearer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, o, tt.authorization)
+			if w.Code != tt.wantCode || w.Header().Get("WWW-Authenticate") != tt.wantChallenge {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Header().Get("WWW-Authenticate"), tt.wantCode, tt.wantChallenge)
+			}
+		})
+	}
+}
+
+func TestOpenIDDiscoveryIssuer(t *testing.T) {
+	keys := newJWKSServer(t)
+	provider, _ := newDiscoveryServer(t, "https://other", keys.URL)
+	o := &OpenID{ConnectUrl: provider.URL + wellKnownOpenID}
+	if _, err := o.Discover(); err == nil || !strings.Contains(err.Error(), "doesn't match") {
+		t.Errorf("Discover() error = %v, want the issuer mismatch", err)
+	}
+	if w := authorize(t, o, "Bearer token"); w.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestOpenIDKeyRotation(t *testing.T) {
+	old, rotated := newRSAKey(t), newRSAKey(t)
+	tests := []struct {
+		name               string
+		minRefreshInterval time.Duration
+		wantCode           int
+		wantRequests       int32
+	}{
+		{"refreshed", time.Nanosecond, http.StatusOK, 2},
+		{"within min refresh interval", time.Hour, http.StatusUnauthorized, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := newJWKSServer(t, rsaJWK("old", old))
+			provider, discoveries := newDiscoveryServer(t, "", keys.URL)
+			o := &OpenID{ConnectUrl: provider.URL + wellKnownOpenID, MinRefreshInterval: tt.minRefreshInterval}
+			claims := jwt.MapClaims{"iss": provider.URL}
+			if w := authorize(t, o, "Bearer "+sign(t, jwt.SigningMethodRS256, old, "old", claims)); w.Code != http.StatusOK {
+				t.Fatalf("status with the old key = %d, want %d", w.Code, http.StatusOK)
+			}
+
+			keys.setKeys(rsaJWK("new", rotated))
+			if w := authorize(t, o, "Bearer "+sign(t, jwt.SigningMethodRS256, rotated, "new", claims)); w.Code != tt.wantCode {
+				t.Errorf("status with the unknown kid = %d, want %d", w.Code, tt.wantCode)
+			}
+			if got := keys.requests.Load(); got != tt.wantRequests {
+				t.Errorf("jwks requests = %d, want %d", got, tt.wantRequests)
+			}
+			if got := discoveries.Load(); got != 1 {
+				t.Errorf("discovery requests = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestOpenIDConcurrentDiscovery(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	var provider *httptest.Server
+	provider = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_ = json.NewEncoder(w).Encode(&Discovery{Issuer: provider.URL, JWKSURI: provider.URL + "/keys"})
+	}))
+	t.Cleanup(provider.Close)
+	o := &OpenID{ConnectUrl: provider.URL + wellKnownOpenID}
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := o.Discover()
+			errs <- err
+		}()
+	}
+	for deadline := time.Now().Add(5 * time.Second); requests.Load() == 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("the discovery document is never fetched")
+		}
+	}
+	// the lock isn't held while fetching
+	if !o.mu.TryLock() {
+		t.Error("the discovery document is fetched holding the lock")
+	} else {
+		o.mu.Unlock()
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Discover() error = %v", err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("discovery requests = %d, want 1", got)
+	}
+}
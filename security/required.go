@@ -0,0 +1,131 @@
+package security
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+)
+
+// Granted is credentials listing the scopes and roles granted to them,
+// otherwise they are read from the scope, scp and roles claims.
+type Granted interface {
+	GetScopes() []string
+	GetRoles() []string
+}
+
+// Required is a security requiring scopes and roles of the credentials on
+// a route, on top of the ones the security requires itself. Both are listed
+// in the security requirement of the operation for oauth2 and openIdConnect
+// schemes, missing ones are rejected with 403.
+type Required struct {
+	ISecurity
+	Scopes []string
+	Roles  []string
+}
+
+// RequireScopes requires scopes of the credentials of s. Composites require
+// them of each of their securities, except Anonymous.
+func RequireScopes(s ISecurity, scopes ...string) ISecurity {
+	return require(s, scopes, nil)
+}
+
+// RequireRoles requires roles of the credentials of s. Composites require
+// them of each of their securities, except Anonymous.
+func RequireRoles(s ISecurity, roles ...string) ISecurity {
+	return require(s, nil, roles)
+}
+
+func require(s ISecurity, scopes, roles []string) ISecurity {
+	switch s := s.(type) {
+	case *Required:
+		return &Required{ISecurity: s.ISecurity, Scopes: slices.Concat(s.Scopes, scopes), Roles: slices.Concat(s.Roles, roles)}
+	case *AnyOf:
+		return &AnyOf{Security: s.Security, Securities: requireAll(s.Securities, scopes, roles)}
+	case *AllOf:
+		return &AllOf{Security: s.Security, Securities: requireAll(s.Securities, scopes, roles)}
+	case *Anonymous:
+		return s
+	}
+	return &Required{ISecurity: s, Scopes: scopes, Roles: roles}
+}
+
+func requireAll(securities []ISecurity, scopes, roles []string) []ISecurity {
+	required := make([]ISecurity, len(securities))
+	for i, s := range securities {
+		required[i] = require(s, scopes, roles)
+	}
+	return required
+}
+
+func (r *Required) Authorize(c *gin.Context) {
+	r.ISecurity.Authorize(c)
+	if rejected(c) {
+		return
+	}
+	credentials, _ := c.Get(Credentials)
+	missing := append(missingScopes(r.Scopes, GrantedScopes(credentials)), missingScopes(r.Roles, GrantedRoles(credentials))...)
+	if len(missing) == 0 {
+		return
+	}
+	err := fmt.Errorf("%w: %s", ErrInsufficientScope, strings.Join(missing, " "))
+	if scheme := r.Scheme(); scheme != nil && (scheme.Type == "oauth2" || scheme.Type == "openIdConnect" || scheme.Scheme == "bearer") {
+		bearerCallback(c, r, nil, err, r.GetRequiredScopes())
+		return
+	}
+	r.Callback(c, nil, err)
+}
+
+// GetRequiredScopes returns the scopes of the security followed by the
+// scopes and roles of the route.
+func (r *Required) GetRequiredScopes() []string {
+	var scopes []string
+	if scoped, ok := r.ISecurity.(Scoped); ok {
+		scopes = append(scopes, scoped.GetRequiredScopes()...)
+	}
+	scopes = append(scopes, r.Scopes...)
+	return append(scopes, r.Roles...)
+}
+
+// GrantedScopes returns the scopes granted to credentials.
+func GrantedScopes(credentials any) []string {
+	if granted, ok := credentials.(Granted); ok {
+		return granted.GetScopes()
+	}
+	return claimScopes(credentials)
+}
+
+// GrantedRoles returns the roles granted to credentials.
+func GrantedRoles(credentials any) []string {
+	if granted, ok := credentials.(Granted); ok {
+		return granted.GetRoles()
+	}
+	return claimStrings(credentials, "roles")
+}
+
+// claimStrings returns the strings of the claim name of claims, a list or a
+// space separated string.
+func claimStrings(claims any, name string) []string {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	var values []string
+	switch v := m[name].(type) {
+	case string:
+		values = strings.Fields(v)
+	case []any:
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
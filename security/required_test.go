@@ -0,0 +1,44 @@
+package security
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestRequired(t *testing.T) {
+	secret := []byte("secret")
+	admin := "Bearer " + sign(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"roles": []string{"admin"}})
+	user := "Bearer " + sign(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"roles": "user", "scope": "read"})
+	basic := &Basic{Verifier: func(c *gin.Context, username, password string) (any, error) {
+		return jwt.MapClaims{"scope": "read"}, nil
+	}}
+	tests := []struct {
+		name          string
+		security      ISecurity
+		authorization string
+		wantCode      int
+		wantChallenge string
+	}{
+		{"role granted", RequireRoles(&JWT{Key: secret}, "admin"), admin, http.StatusOK, ""},
+		{"role missing", RequireRoles(&JWT{Key: secret}, "admin"), user, http.StatusForbidden, `Bearer error="insufficient_scope", scope="admin"`},
+		{"scope granted", RequireScopes(&JWT{Key: secret}, "read"), user, http.StatusOK, ""},
+		{"invalid token", RequireRoles(&JWT{Key: secret}, "admin"), "Bearer token", http.StatusUnauthorized, `Bearer error="invalid_token"`},
+		{"scope missing without bearer", RequireScopes(basic, "write"), "Basic dXNlcjpwYXNz", http.StatusForbidden, ""},
+		{"any role granted", RequireRoles(Any(&JWT{Key: secret}, basic), "admin"), admin, http.StatusOK, ""},
+		{"any role missing", RequireRoles(Any(&JWT{Key: secret}, basic), "admin"), user, http.StatusForbidden, `Bearer error="insufficient_scope", scope="admin"`},
+		{"any role missing without bearer", RequireRoles(Any(&JWT{Key: secret}, basic), "admin"), "Basic dXNlcjpwYXNz", http.StatusForbidden, "Bearer"},
+		{"optional anonymous", RequireRoles(Optional(&JWT{Key: secret}), "admin"), "", http.StatusOK, ""},
+		{"optional role missing falls back to anonymous", RequireRoles(Optional(&JWT{Key: secret}), "admin"), user, http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := authorize(t, tt.security, tt.authorization)
+			if w.Code != tt.wantCode || w.Header().Get("WWW-Authenticate") != tt.wantChallenge {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Header().Get("WWW-Authenticate"), tt.wantCode, tt.wantChallenge)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package security
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -16,6 +17,24 @@ const (
 	OAuth2Auth  = "OAuth2Auth"
 )
 
+// ErrInsufficientScope is the error of valid credentials lacking a required
+// scope, Callback responds with 403 instead of 401 to it.
+var ErrInsufficientScope = errors.New("insufficient scope")
+
+// CredentialsKey is the key of the credentials of the scheme named scheme,
+// set along Credentials, which only holds the last credentials of a route.
+func CredentialsKey(scheme string) string {
+	return Credentials + "." + scheme
+}
+
+// Get returns the credentials of the scheme named scheme, the provider of a
+// security or its SchemeName, if the scheme authorized the request with
+// credentials of type T.
+func Get[T any](c *gin.Context, scheme string) (T, bool) {
+	credentials, ok := c.Value(CredentialsKey(scheme)).(T)
+	return credentials, ok
+}
+
 type ISecurity interface {
 	Authorize(g *gin.Context)
 	Callback(c *gin.Context, credentials any, err error)
@@ -23,12 +42,33 @@ type ISecurity interface {
 	Scheme() *openapi3.SecurityScheme
 }
 
+// Scoped is a security requiring scopes, listed in the security
+// requirements of the docs.
+type Scoped interface {
+	GetRequiredScopes() []string
+}
+
 type Security struct {
 	ISecurity
+	// SchemeName names the scheme in the docs, the provider of the security
+	// type when empty. Name several securities of the same type apart.
+	SchemeName string
+	// Description describes the scheme in the docs.
+	Description string
+}
+
+// provider returns SchemeName, or name when empty.
+func (s *Security) provider(name string) string {
+	if s.SchemeName != "" {
+		return s.SchemeName
+	}
+	return name
 }
 
 func (s *Security) Callback(c *gin.Context, credentials any, err error) {
-	if err != nil {
+	if errors.Is(err, ErrInsufficientScope) {
+		c.AbortWithStatus(http.StatusForbidden)
+	} else if err != nil {
 		c.AbortWithStatus(http.StatusUnauthorized)
 	} else {
 		c.Set(Credentials, credentials)
@@ -0,0 +1,155 @@
+package swagin
+
+import (
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+
+	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/security"
+	"github.com/x-research-team/swagin/swagger"
+)
+
+func TestRequiredScopesDocs(t *testing.T) {
+	jwt := &security.JWT{Key: []byte("secret")}
+	oauth := &security.OAuth2{AuthorizationURL: "https://auth/authorize", TokenURL: "https://auth/token", RequiredScopes: []string{"orders"}}
+	app := New(swagger.New("Test", "", "1.0.0"))
+	ok := func(c *gin.Context) {}
+	app.DELETE("/users", router.NewX(ok, router.Security(security.RequireRoles(jwt, "admin"))))
+	app.POST("/orders", router.NewX(ok, router.Security(security.RequireScopes(oauth, "orders:write"))))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path, method string
+		want         openapi3.SecurityRequirement
+	}{
+		{"/users", http.MethodDelete, openapi3.SecurityRequirement{security.BearerAuth: {}}},
+		{"/orders", http.MethodPost, openapi3.SecurityRequirement{security.OAuth2Auth: {"orders", "orders:write"}}},
+	}
+	for _, tt := range tests {
+		operation := app.Swagger.OpenAPI.Paths.Find(tt.path).GetOperation(tt.method)
+		if operation.Security == nil || len(*operation.Security) != 1 {
+			t.Fatalf("%s %s security = %v, want %v", tt.method, tt.path, operation.Security, tt.want)
+		}
+		if got := (*operation.Security)[0]; !maps.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("%s %s security = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+
+	operation := app.Swagger.OpenAPI.Paths.Find("/users").GetOperation(http.MethodDelete)
+	operation.Security = openapi3.NewSecurityRequirements().With(openapi3.SecurityRequirement{security.BearerAuth: {"admin"}})
+	if err := app.Swagger.Validate(); err == nil || !strings.Contains(err.Error(), `lists scopes for http scheme "BearerAuth"`) {
+		t.Errorf("Validate() = %v, want the scopes of the http scheme reported", err)
+	}
+}
+
+func TestApiKeyDocs(t *testing.T) {
+	key := &security.ApiKey{Name: "api_key", In: security.InQuery, Validator: security.StaticKeys("key")}
+	app := New(swagger.New("Test", "", "1.0.0", swagger.Security(key)))
+	app.GET("/x", router.NewX(func(c *gin.Context) { c.Status(http.StatusOK) }))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	for url, want := range map[string]int{"/x": http.StatusUnauthorized, "/x?api_key=other": http.StatusUnauthorized, "/x?api_key=key": http.StatusOK} {
+		if w := get(t, app, url); w.Code != want {
+			t.Errorf("GET %s = %d, want %d", url, w.Code, want)
+		}
+	}
+	if w := get(t, app, "/openapi.json"); !strings.Contains(w.Body.String(), `"ApiKeyAuth":{"in":"query","name":"api_key","type":"apiKey"}`) {
+		t.Errorf("GET /openapi.json = %s, want the query apiKey scheme", w.Body.String())
+	}
+}
+
+// customAny is a composite require can't see through.
+type customAny struct {
+	*security.AnyOf
+}
+
+func TestRequiredComposite(t *testing.T) {
+	jwt := &security.JWT{Key: []byte("secret")}
+	basic := &security.Basic{}
+	app := New(swagger.New("Test", "", "1.0.0"))
+	app.DELETE("/users", router.NewX(func(c *gin.Context) {}, router.Security(security.RequireRoles(security.Any(jwt, basic), "admin"))))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	operation := app.Swagger.OpenAPI.Paths.Find("/users").Delete
+	want := openapi3.SecurityRequirements{{security.BearerAuth: {}}, {security.BasicAuth: {}}}
+	if operation.Security == nil || !slices.EqualFunc(*operation.Security, want, func(a, b openapi3.SecurityRequirement) bool {
+		return maps.EqualFunc(a, b, slices.Equal)
+	}) {
+		t.Errorf("security = %v, want %v", operation.Security, want)
+	}
+
+	app = New(swagger.New("Test", "", "1.0.0"))
+	app.DELETE("/users", router.NewX(func(c *gin.Context) {}, router.Security(security.RequireRoles(customAny{security.Any(jwt, basic)}, "admin"))))
+	if err := app.InitE(); err == nil || !strings.Contains(err.Error(), "has no scheme") {
+		t.Errorf("InitE() = %v, want the security without scheme reported", err)
+	}
+}
+
+func TestCredentials(t *testing.T) {
+	basic := &security.Basic{Verifier: security.StaticUsers(map[string]string{"alice": "secret"})}
+	key := &security.ApiKey{Name: "X-Key"}
+	app := New(swagger.New("Test", "", "1.0.0"))
+	app.GET("/get", router.New(func(c *gin.Context, req struct{}) {
+		user, userOK := security.Get[*security.User](c, security.BasicAuth)
+		apiKey, keyOK := security.Get[string](c, security.ApiKeyAuth)
+		_, wrongOK := security.Get[string](c, security.BasicAuth)
+		if !userOK || !keyOK || wrongOK {
+			c.String(http.StatusOK, "user %v, key %v, wrong type %v", userOK, keyOK, wrongOK)
+			return
+		}
+		c.String(http.StatusOK, "%s %s", user.Username, apiKey)
+	}, router.Security(basic, key)))
+	app.GET("/auth", router.New(func(c *gin.Context, req struct {
+		Auth *security.User
+	}) {
+		c.String(http.StatusOK, "%s", req.Auth.Username)
+	}, router.Security(basic, key)))
+	app.GET("/scheme", router.New(func(c *gin.Context, req struct {
+		Auth string `scheme:"ApiKeyAuth"`
+	}) {
+		c.String(http.StatusOK, "%s", req.Auth)
+	}, router.Security(basic, key)))
+	app.GET("/wrong", router.New(func(c *gin.Context, req struct {
+		Auth *security.User `scheme:"ApiKeyAuth"`
+	}) {
+		c.String(http.StatusOK, "%v", req.Auth)
+	}, router.Security(basic, key)))
+	app.GET("/anonymous", router.New(func(c *gin.Context, req struct {
+		Auth *security.User
+	}) {
+		_, ok := security.Get[*security.User](c, security.BasicAuth)
+		c.String(http.StatusOK, "%v %v", req.Auth, ok)
+	}, router.Security(security.Optional(basic))))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+
+	for url, want := range map[string]string{
+		"/get":       "alice key",
+		"/auth":      "alice",
+		"/scheme":    "key",
+		"/wrong":     "<nil>",
+		"/anonymous": "<nil> false",
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		if url != "/anonymous" {
+			r.SetBasicAuth("alice", "secret")
+			r.Header.Set("X-Key", "key")
+		}
+		app.ServeHTTP(w, r)
+		if w.Code != http.StatusOK || w.Body.String() != want {
+			t.Errorf("GET %s = %d %q, want %d %q", url, w.Code, w.Body.String(), http.StatusOK, want)
+		}
+	}
+}
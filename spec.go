@@ -0,0 +1,191 @@
+package swagin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// SpecCacheControl is the Cache-Control of the spec responses, clients
+// revalidate with the ETag since the spec changes with every deploy.
+const SpecCacheControl = "no-cache"
+
+var (
+	jsonTypes = []string{binding.MIMEJSON}
+	yamlTypes = []string{"application/yaml", binding.MIMEYAML, "text/yaml"}
+)
+
+// encoded is a serialised spec in one content encoding.
+type encoded struct {
+	body []byte
+	etag string
+}
+
+// spec is a document serialised once, with its compressed variants.
+type spec struct {
+	contentType string
+	encodings   map[string]*encoded
+}
+
+func newSpec(contentType string, body []byte) *spec {
+	sum := sha256.Sum256(body)
+	etag := hex.EncodeToString(sum[:16])
+	s := &spec{
+		contentType: contentType,
+		encodings:   map[string]*encoded{"identity": {body: body, etag: `"` + etag + `"`}},
+	}
+	var buf bytes.Buffer
+	gw, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	gw.Write(body)
+	gw.Close()
+	s.encodings["gzip"] = &encoded{body: bytes.Clone(buf.Bytes()), etag: `"` + etag + `-gzip"`}
+	buf.Reset()
+	bw := brotli.NewWriterLevel(&buf, brotli.BestCompression)
+	bw.Write(body)
+	bw.Close()
+	s.encodings["br"] = &encoded{body: bytes.Clone(buf.Bytes()), etag: `"` + etag + `-br"`}
+	return s
+}
+
+// specHandler serves the JSON or YAML form of a document, selected with the
+// Accept header and defaulting to YAML when url ends with .yml or .yaml.
+func specHandler(url string, marshalJSON, marshalYAML func() ([]byte, error)) (gin.HandlerFunc, error) {
+	j, err := marshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	y, err := marshalYAML()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	jsonSpec := newSpec(binding.MIMEJSON+"; charset=utf-8", j)
+	yamlSpec := newSpec("application/yaml; charset=utf-8", y)
+	offered := slices.Concat(jsonTypes, yamlTypes)
+	if isYAML(url) {
+		offered = slices.Concat(yamlTypes, jsonTypes)
+	}
+	return func(c *gin.Context) {
+		s := jsonSpec
+		if format := negotiateFormat(c.GetHeader("Accept"), offered); format == "" {
+			c.AbortWithStatus(http.StatusNotAcceptable)
+			return
+		} else if !strings.HasPrefix(format, binding.MIMEJSON) {
+			s = yamlSpec
+		}
+		s.serve(c)
+	}, nil
+}
+
+func (s *spec) serve(c *gin.Context) {
+	encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+	e := s.encodings[encoding]
+	header := c.Writer.Header()
+	header.Set("ETag", e.etag)
+	header.Set("Cache-Control", SpecCacheControl)
+	header.Add("Vary", "Accept")
+	header.Add("Vary", "Accept-Encoding")
+	if etagMatch(c.GetHeader("If-None-Match"), e.etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	if encoding != "identity" {
+		header.Set("Content-Encoding", encoding)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(e.body)))
+	c.Data(http.StatusOK, s.contentType, e.body)
+}
+
+// negotiateFormat picks the offered type with the highest weight in Accept,
+// the first offered one on a tie or without Accept. The most specific media
+// range of a type gives its weight, q=0 refuses it.
+func negotiateFormat(accept string, offered []string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offered[0]
+	}
+	weights := make(map[string]float64)
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		mediaRange = strings.ToLower(strings.TrimSpace(mediaRange))
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if v, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if mediaRange != "" {
+			weights[mediaRange] = q
+		}
+	}
+	best, bestQ := "", 0.0
+	for _, format := range offered {
+		kind, _, _ := strings.Cut(format, "/")
+		q, ok := weights[format]
+		if !ok {
+			if q, ok = weights[kind+"/*"]; !ok {
+				q = weights["*/*"]
+			}
+		}
+		if q > bestQ {
+			best, bestQ = format, q
+		}
+	}
+	return best
+}
+
+// negotiateEncoding picks br or gzip by the weights of Accept-Encoding,
+// preferring br on a tie. * weighs the encodings not listed, q=0 refuses one.
+func negotiateEncoding(accept string) string {
+	weights := make(map[string]float64)
+	for _, part := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if name != "" {
+			weights[name] = q
+		}
+	}
+	best, bestQ := "identity", 0.0
+	for _, name := range []string{"br", "gzip"} {
+		q, ok := weights[name]
+		if !ok {
+			q = weights["*"]
+		}
+		if q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+// etagMatch reports whether If-None-Match matches etag, weak validators
+// match too as RFC 9110 requires for If-None-Match.
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func isYAML(url string) bool {
+	return strings.HasSuffix(url, ".yml") || strings.HasSuffix(url, ".yaml")
+}
@@ -0,0 +1,156 @@
+package swagin
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	specJSON = `{"openapi":"3.0.0"}`
+	specYAML = "openapi: 3.0.0\n"
+)
+
+func newSpecEngine(t *testing.T, url string) *gin.Engine {
+	t.Helper()
+	handler, err := specHandler(url, func() ([]byte, error) {
+		return []byte(specJSON), nil
+	}, func() ([]byte, error) {
+		return []byte(specYAML), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine := gin.New()
+	engine.GET(url, handler)
+	return engine
+}
+
+func getSpec(engine http.Handler, url string, header http.Header) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, url, nil)
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	engine.ServeHTTP(w, r)
+	return w
+}
+
+func TestSpecFormat(t *testing.T) {
+	tests := []struct {
+		url, accept string
+		wantCode    int
+		wantBody    string
+	}{
+		{"/openapi.json", "", http.StatusOK, specJSON},
+		{"/openapi.json", "*/*", http.StatusOK, specJSON},
+		{"/openapi.json", "application/yaml", http.StatusOK, specYAML},
+		{"/openapi.json", "application/x-yaml", http.StatusOK, specYAML},
+		{"/openapi.json", "application/yaml;q=0.5, application/json", http.StatusOK, specJSON},
+		{"/openapi.yaml", "", http.StatusOK, specYAML},
+		{"/openapi.yaml", "application/json", http.StatusOK, specJSON},
+		{"/openapi.json", "application/json;q=0, */*", http.StatusOK, specYAML},
+		{"/openapi.json", "text/*", http.StatusOK, specYAML},
+		{"/openapi.json", "text/html", http.StatusNotAcceptable, ""},
+		{"/openapi.json", "*/*;q=0", http.StatusNotAcceptable, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url+" "+tt.accept, func(t *testing.T) {
+			w := getSpec(newSpecEngine(t, tt.url), tt.url, http.Header{"Accept": {tt.accept}})
+			if w.Code != tt.wantCode || w.Body.String() != tt.wantBody {
+				t.Errorf("response = %d %q, want %d %q", w.Code, w.Body.String(), tt.wantCode, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestSpecEncoding(t *testing.T) {
+	engine := newSpecEngine(t, "/openapi.json")
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"gzip, br", "br"},
+		{"br;q=0.5, gzip", "gzip"},
+		{"br;q=0, gzip", "gzip"},
+		{"gzip;q=0", ""},
+		{"*", "br"},
+		{"br;q=0, *", "gzip"},
+		{"*;q=0", ""},
+		{"deflate", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			w := getSpec(engine, "/openapi.json", http.Header{"Accept-Encoding": {tt.acceptEncoding}})
+			if got := w.Header().Get("Content-Encoding"); got != tt.want {
+				t.Fatalf("Content-Encoding = %q, want %q", got, tt.want)
+			}
+			var body io.Reader = w.Body
+			switch tt.want {
+			case "gzip":
+				gr, err := gzip.NewReader(w.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = gr
+			case "br":
+				body = brotli.NewReader(w.Body)
+			}
+			data, err := io.ReadAll(body)
+			if err != nil || string(data) != specJSON {
+				t.Errorf("body = %q, %v, want %q", data, err, specJSON)
+			}
+		})
+	}
+}
+
+func TestSpecETag(t *testing.T) {
+	engine := newSpecEngine(t, "/openapi.json")
+	w := getSpec(engine, "/openapi.json", nil)
+	etag := w.Header().Get("ETag")
+	if etag == "" || w.Header().Get("Cache-Control") != SpecCacheControl {
+		t.Fatalf("ETag = %q, Cache-Control = %q", etag, w.Header().Get("Cache-Control"))
+	}
+	if gzipped := getSpec(engine, "/openapi.json", http.Header{"Accept-Encoding": {"gzip"}}); gzipped.Header().Get("ETag") == etag {
+		t.Error("the gzip encoding has the ETag of the identity one")
+	}
+	tests := []struct {
+		ifNoneMatch string
+		wantCode    int
+	}{
+		{etag, http.StatusNotModified},
+		{"W/" + etag, http.StatusNotModified},
+		{`"other", ` + etag, http.StatusNotModified},
+		{"*", http.StatusNotModified},
+		{`"other"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		w := getSpec(engine, "/openapi.json", http.Header{"If-None-Match": {tt.ifNoneMatch}})
+		if w.Code != tt.wantCode {
+			t.Errorf("If-None-Match %s = %d, want %d", tt.ifNoneMatch, w.Code, tt.wantCode)
+		}
+		if w.Code == http.StatusNotModified && (w.Body.Len() != 0 || w.Header().Get("ETag") != etag) {
+			t.Errorf("If-None-Match %s = body %q, ETag %q, want no body and the ETag", tt.ifNoneMatch, w.Body.String(), w.Header().Get("ETag"))
+		}
+	}
+}
+
+func TestSpecHandlerError(t *testing.T) {
+	_, err := specHandler("/openapi.json", func() ([]byte, error) {
+		return []byte(specJSON), nil
+	}, func() ([]byte, error) {
+		return nil, errors.New("unsupported value")
+	})
+	if err == nil || !strings.Contains(err.Error(), "unsupported value") {
+		t.Errorf("specHandler() error = %v, want the marshal error", err)
+	}
+}
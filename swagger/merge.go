@@ -0,0 +1,196 @@
+package swagger
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/goccy/go-json"
+)
+
+var componentNameRegex = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Merge adds the paths, components and tags of src to dst, src itself is
+// left untouched. Paths are prefixed with prefix and operation tags are
+// namespaced like namespace/tag, untagged operations are tagged namespace.
+// Components equal to the ones of dst with the same name are shared, the
+// others are renamed with the namespace along with the references to them.
+func Merge(dst, src *openapi3.T, prefix, namespace string) error {
+	data, err := src.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var doc map[string]any
+	if err = json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if dst.Components == nil {
+		components := openapi3.NewComponents()
+		dst.Components = &components
+	}
+	if data, err = dst.Components.MarshalJSON(); err != nil {
+		return err
+	}
+	var existing map[string]map[string]any
+	if err = json.Unmarshal(data, &existing); err != nil {
+		return err
+	}
+
+	components, _ := doc["components"].(map[string]any)
+	componentPrefix := componentNameRegex.ReplaceAllString(namespace, "_") + "."
+	renames := make(map[string]string)
+	schemes := make(map[string]string)
+	for _, kind := range slices.Sorted(maps.Keys(components)) {
+		byName, _ := components[kind].(map[string]any)
+		for _, name := range slices.Sorted(maps.Keys(byName)) {
+			current, ok := existing[kind][name]
+			if !ok {
+				continue
+			}
+			if reflect.DeepEqual(current, byName[name]) {
+				delete(byName, name)
+				continue
+			}
+			renamed := componentPrefix + name
+			for i := 2; existing[kind][renamed] != nil || byName[renamed] != nil; i++ {
+				renamed = fmt.Sprintf("%s%s%d", componentPrefix, name, i)
+			}
+			byName[renamed] = byName[name]
+			delete(byName, name)
+			renames["#/components/"+kind+"/"+name] = "#/components/" + kind + "/" + renamed
+			if kind == "securitySchemes" {
+				schemes[name] = renamed
+			}
+		}
+	}
+	rewriteRefs(doc, renames)
+
+	paths, _ := doc["paths"].(map[string]any)
+	for _, path := range slices.Sorted(maps.Keys(paths)) {
+		pathItem, _ := paths[path].(map[string]any)
+		for method, value := range pathItem {
+			operation, ok := value.(map[string]any)
+			if !ok || method == "parameters" || method == "servers" {
+				continue
+			}
+			// the security of src doesn't carry over to dst, so move it
+			// to the operations, and the ones src leaves public must not
+			// get the security of dst
+			if _, ok = operation["security"]; !ok {
+				if doc["security"] != nil {
+					operation["security"] = doc["security"]
+				} else if len(dst.Security) != 0 {
+					operation["security"] = []any{}
+				}
+			}
+			if security, ok := operation["security"].([]any); ok {
+				for _, requirement := range security {
+					if requirement, ok := requirement.(map[string]any); ok {
+						for name, renamed := range schemes {
+							if scopes, ok := requirement[name]; ok {
+								delete(requirement, name)
+								requirement[renamed] = scopes
+							}
+						}
+					}
+				}
+			}
+			tags, _ := operation["tags"].([]any)
+			if len(tags) == 0 {
+				tags = []any{namespace}
+			} else {
+				for i, tag := range tags {
+					tags[i] = fmt.Sprintf("%s/%s", namespace, tag)
+				}
+			}
+			operation["tags"] = tags
+		}
+	}
+	if tags, ok := doc["tags"].([]any); ok {
+		for _, tag := range tags {
+			if tag, ok := tag.(map[string]any); ok {
+				tag["name"] = fmt.Sprintf("%s/%s", namespace, tag["name"])
+			}
+		}
+	}
+
+	if data, err = json.Marshal(doc); err != nil {
+		return err
+	}
+	merged := &openapi3.T{}
+	if err = merged.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	var errs []string
+	if merged.Paths != nil {
+		for _, path := range slices.Sorted(maps.Keys(merged.Paths.Map())) {
+			full := strings.TrimSuffix(prefix+path, "/")
+			if full == "" {
+				full = "/"
+			}
+			if dst.Paths == nil {
+				dst.Paths = openapi3.NewPaths()
+			}
+			if dst.Paths.Value(full) != nil {
+				errs = append(errs, fmt.Sprintf("path %q is already defined", full))
+				continue
+			}
+			dst.Paths.Set(full, merged.Paths.Value(path))
+		}
+	}
+	if merged.Components != nil {
+		c := merged.Components
+		mergeComponents(&dst.Components.Schemas, c.Schemas)
+		mergeComponents(&dst.Components.Parameters, c.Parameters)
+		mergeComponents(&dst.Components.Headers, c.Headers)
+		mergeComponents(&dst.Components.RequestBodies, c.RequestBodies)
+		mergeComponents(&dst.Components.Responses, c.Responses)
+		mergeComponents(&dst.Components.SecuritySchemes, c.SecuritySchemes)
+		mergeComponents(&dst.Components.Examples, c.Examples)
+		mergeComponents(&dst.Components.Links, c.Links)
+		mergeComponents(&dst.Components.Callbacks, c.Callbacks)
+	}
+	dst.Tags = append(dst.Tags, merged.Tags...)
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return openapi3.NewLoader().ResolveRefsIn(dst, nil)
+}
+
+func mergeComponents[M ~map[string]V, V any](dst *M, src M) {
+	if len(src) == 0 {
+		return
+	}
+	if *dst == nil {
+		*dst = make(M, len(src))
+	}
+	maps.Copy(*dst, src)
+}
+
+// rewriteRefs replaces the $ref values of value found in renames.
+func rewriteRefs(value any, renames map[string]string) {
+	if len(renames) == 0 {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if ref, ok := item.(string); ok && key == "$ref" {
+				if renamed, ok := renames[ref]; ok {
+					v[key] = renamed
+				}
+				continue
+			}
+			rewriteRefs(item, renames)
+		}
+	case []any:
+		for _, item := range v {
+			rewriteRefs(item, renames)
+		}
+	}
+}
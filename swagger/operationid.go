@@ -0,0 +1,77 @@
+package swagger
+
+import (
+	"runtime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goccy/go-reflect"
+
+	"github.com/x-research-team/swagin/router"
+)
+
+// OperationIDFunc generates the operationId of a router that has none set
+// with router.OperationID. path is the full path the router is served at,
+// including the root path of mounted apps.
+type OperationIDFunc func(method, path string, r *router.Router) string
+
+// MethodPathOperationID generates ids like getUsersById from GET /users/:id.
+func MethodPathOperationID(method, path string, _ *router.Router) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if segment[0] == ':' || segment[0] == '*' || segment[0] == '{' {
+			b.WriteString("By")
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			first, size := utf8.DecodeRuneInString(word)
+			b.WriteRune(unicode.ToUpper(first))
+			b.WriteString(word[size:])
+		}
+	}
+	return b.String()
+}
+
+// HandlerOperationID uses the name of the handler function, like
+// GetUser for a router built with router.New(GetUser). Anonymous handlers
+// fall back to MethodPathOperationID.
+func HandlerOperationID(method, path string, r *router.Router) string {
+	if r.Handler != nil {
+		if f := runtime.FuncForPC(reflect.ValueOf(r.Handler).Pointer()); f != nil {
+			name := f.Name()
+			if i := strings.Index(name, "["); i != -1 {
+				name = name[:i]
+			}
+			name = name[strings.LastIndex(name, "/")+1:]
+			name = strings.TrimSuffix(name, "-fm")
+			name = name[strings.LastIndex(name, ".")+1:]
+			if name != "" && !isAnonymous(name) {
+				return name
+			}
+		}
+	}
+	return MethodPathOperationID(method, path, r)
+}
+
+// isAnonymous reports whether name is a compiler generated closure name
+// like func1.
+func isAnonymous(name string) bool {
+	digits := strings.TrimPrefix(name, "func")
+	if digits == name || digits == "" {
+		return false
+	}
+	return strings.IndexFunc(digits, func(r rune) bool { return !unicode.IsDigit(r) }) == -1
+}
+
+func (swagger *Swagger) getOperationID(method, path string, r *router.Router) string {
+	if r.OperationID != "" || swagger.OperationIDFunc == nil {
+		return r.OperationID
+	}
+	return swagger.OperationIDFunc(method, swagger.RootPath+path, r)
+}
@@ -0,0 +1,66 @@
+package swagger
+
+import (
+	"net/http"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/x-research-team/swagin/router"
+)
+
+func TestMethodPathOperationID(t *testing.T) {
+	tests := []struct {
+		method, path, want string
+	}{
+		{http.MethodGet, "/users/:id", "getUsersById"},
+		{http.MethodPost, "/users", "postUsers"},
+		{http.MethodGet, "/files/*path", "getFilesByPath"},
+		{http.MethodDelete, "/api/v1/user-groups/{id}", "deleteApiV1UserGroupsById"},
+		{http.MethodGet, "/été/:id", "getÉtéById"},
+		{http.MethodGet, "/", "get"},
+	}
+	for _, tt := range tests {
+		got := MethodPathOperationID(tt.method, tt.path, nil)
+		if got != tt.want {
+			t.Errorf("MethodPathOperationID(%s, %s) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("MethodPathOperationID(%s, %s) = %q is invalid UTF-8", tt.method, tt.path, got)
+		}
+	}
+}
+
+func GetUser(c *gin.Context) {}
+
+type userHandlers struct{}
+
+func (userHandlers) ListUsers(c *gin.Context) {}
+
+func GetGeneric[T any](c *gin.Context) {}
+
+type userQuery struct{}
+
+func FindUser(c *gin.Context, req userQuery) {}
+
+func TestHandlerOperationID(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *router.Router
+		want string
+	}{
+		{"named function", router.NewX(GetUser), "GetUser"},
+		{"model function", router.New(FindUser), "FindUser"},
+		{"method value", router.NewX(userHandlers{}.ListUsers), "ListUsers"},
+		{"generic function", router.NewX(GetGeneric[int]), "GetGeneric"},
+		{"closure", router.NewX(func(c *gin.Context) {}), "getUsersById"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HandlerOperationID(http.MethodGet, "/users/:id", tt.r); got != tt.want {
+				t.Errorf("HandlerOperationID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
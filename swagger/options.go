@@ -1,9 +1,13 @@
 package swagger
 
 import (
+	"io/fs"
+
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
 
 	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/security"
 )
 
 type Option func(swagger *Swagger)
@@ -43,6 +47,34 @@ func OpenAPIUrl(url string) Option {
 		swagger.OpenAPIUrl = url
 	}
 }
+
+// SwaggerUrl serve the docs converted to Swagger 2.0, disabled when empty
+func SwaggerUrl(url string) Option {
+	return func(swagger *Swagger) {
+		swagger.SwaggerUrl = url
+	}
+}
+
+// OperationIDs set how operationIds are generated for routers without one, nil disables generation
+func OperationIDs(f OperationIDFunc) Option {
+	return func(swagger *Swagger) {
+		swagger.OperationIDFunc = f
+	}
+}
+
+// AssetsUrl set where the embedded Swagger UI and ReDoc bundles are served
+func AssetsUrl(url string) Option {
+	return func(swagger *Swagger) {
+		swagger.AssetsUrl = url
+	}
+}
+
+// CDN load Swagger UI and ReDoc from jsDelivr instead of the embedded bundles
+func CDN() Option {
+	return func(swagger *Swagger) {
+		swagger.CDN = true
+	}
+}
 func Servers(servers openapi3.Servers) Option {
 	return func(swagger *Swagger) {
 		swagger.Servers = servers
@@ -73,3 +105,125 @@ func RedocOptions(options map[string]any) Option {
 		swagger.RedocOptions = options
 	}
 }
+
+// DocUI serve a documentation UI, replacing the one with the same name
+func DocUI(ui *UI) Option {
+	return func(swagger *Swagger) {
+		for i, u := range swagger.UIs {
+			if u.Name == ui.Name {
+				swagger.UIs[i] = ui
+				return
+			}
+		}
+		swagger.UIs = append(swagger.UIs, ui)
+	}
+}
+func ScalarUrl(url string) Option {
+	return DocUI(&UI{Name: Scalar, Url: url})
+}
+func RapiDocUrl(url string) Option {
+	return DocUI(&UI{Name: RapiDoc, Url: url})
+}
+func ElementsUrl(url string) Option {
+	return DocUI(&UI{Name: Elements, Url: url})
+}
+
+// Templates override the docs templates with the *.html files of fsys, named after their UI like swagger.html
+func Templates(fsys fs.FS) Option {
+	return func(swagger *Swagger) {
+		swagger.Templates = fsys
+	}
+}
+
+// TitleFormat set the title of the docs pages, {title} and {ui} are replaced with the docs title and the UI name
+func TitleFormat(format string) Option {
+	return func(swagger *Swagger) {
+		swagger.TitleFormat = format
+	}
+}
+
+// Logo show the image at url on top of the docs pages
+func Logo(url string) Option {
+	return func(swagger *Swagger) {
+		swagger.Logo = url
+	}
+}
+func Favicon(url string) Option {
+	return func(swagger *Swagger) {
+		swagger.Favicon = url
+	}
+}
+
+// CustomCSS add a stylesheet to the docs pages
+func CustomCSS(css string) Option {
+	return func(swagger *Swagger) {
+		swagger.CustomCSS = css
+	}
+}
+
+// CustomJS add a script to the docs pages
+func CustomJS(js string) Option {
+	return func(swagger *Swagger) {
+		swagger.CustomJS = js
+	}
+}
+
+// CSP set the Content-Security-Policy header of the docs pages, {nonce} is replaced with the nonce of the request, empty disables the header
+func CSP(policy string) Option {
+	return func(swagger *Swagger) {
+		swagger.CSP = policy
+	}
+}
+
+// OAuth set the initOAuth config of Swagger UI, used by the Authorize dialog of OAuth2 and OpenID Connect schemes
+func OAuth(config *OAuthConfig) Option {
+	return func(swagger *Swagger) {
+		swagger.OAuth = config
+	}
+}
+
+// Security protect the routes without securities of their own or of their
+// groups with securities, listed as the security of the whole document,
+// public routes excepted
+func Security(securities ...security.ISecurity) Option {
+	return func(swagger *Swagger) {
+		swagger.Securities = append(swagger.Securities, securities...)
+	}
+}
+
+// DocsSecurity protect the docs, spec and assets routes with securities
+func DocsSecurity(securities ...security.ISecurity) Option {
+	return func(swagger *Swagger) {
+		swagger.DocsSecurities = append(swagger.DocsSecurities, securities...)
+	}
+}
+
+// DocsHandlers run handlers like gin.BasicAuth before the docs, spec and assets routes
+func DocsHandlers(handlers ...gin.HandlerFunc) Option {
+	return func(swagger *Swagger) {
+		swagger.DocsHandlers = append(swagger.DocsHandlers, handlers...)
+	}
+}
+
+// DisableDocs don't serve the docs, spec and assets routes, the spec is still built for export
+func DisableDocs() Option {
+	return func(swagger *Swagger) {
+		swagger.DisableDocs = true
+	}
+}
+
+// Tags declare tags with their description and external docs, listed in the docs in this order
+func Tags(tags ...*openapi3.Tag) Option {
+	return func(swagger *Swagger) {
+		for _, tag := range tags {
+			swagger.AddTag(tag)
+		}
+	}
+}
+
+// TagGroups group tags in the navigation of ReDoc with x-tagGroups
+func TagGroups(groups ...TagGroup) Option {
+	return func(swagger *Swagger) {
+		swagger.TagGroups = append(swagger.TagGroups, groups...)
+	}
+}
@@ -1,6 +1,10 @@
 package swagger
 
 import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"maps"
 	"mime/multipart"
 	"net/http"
 	"regexp"
@@ -10,6 +14,7 @@ import (
 
 	"github.com/fatih/structtag"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/goccy/go-json"
 	"github.com/goccy/go-reflect"
@@ -35,42 +40,126 @@ const (
 )
 
 type Swagger struct {
-	Title          string
-	Description    string
-	Version        string
-	DocsUrl        string
-	RedocUrl       string
-	OpenAPIUrl     string
-	Routers        map[string]map[string]*router.Router
-	Servers        openapi3.Servers
-	TermsOfService string
-	Contact        *openapi3.Contact
-	License        *openapi3.License
-	OpenAPI        *openapi3.T
-	SwaggerOptions map[string]any
-	RedocOptions   map[string]any
+	Title           string
+	Description     string
+	Version         string
+	DocsUrl         string
+	RedocUrl        string
+	OpenAPIUrl      string
+	SwaggerUrl      string
+	AssetsUrl       string
+	CDN             bool
+	RootPath        string
+	PathPrefix      string
+	Routers         map[string]map[string]*router.Router
+	OperationIDFunc OperationIDFunc
+	Servers         openapi3.Servers
+	TermsOfService  string
+	Contact         *openapi3.Contact
+	License         *openapi3.License
+	Tags            openapi3.Tags
+	TagGroups       []TagGroup
+	Securities      []security.ISecurity
+	OpenAPI         *openapi3.T
+	SwaggerOptions  map[string]any
+	RedocOptions    map[string]any
+	UIs             []*UI
+	Templates       fs.FS
+	TitleFormat     string
+	Logo            string
+	Favicon         string
+	CustomCSS       string
+	CustomJS        string
+	CSP             string
+	OAuth           *OAuthConfig
+	DocsSecurities  []security.ISecurity
+	DocsHandlers    []gin.HandlerFunc
+	DisableDocs     bool
+
+	conflicts []error
+}
+
+// DefaultCSP only allows the scripts of the docs pages and
+// the scripts they load.
+const DefaultCSP = "script-src 'nonce-{nonce}' 'strict-dynamic'; worker-src 'self' blob:; object-src 'none'; base-uri 'self'"
+
+// GetDocsHandlers returns the handlers run before the docs and spec
+// handlers, the Authorize of DocsSecurities followed by DocsHandlers.
+func (swagger *Swagger) GetDocsHandlers() []gin.HandlerFunc {
+	var handlers []gin.HandlerFunc
+	for _, s := range swagger.DocsSecurities {
+		handlers = append(handlers, s.Authorize)
+	}
+	return append(handlers, swagger.DocsHandlers...)
 }
 
+// XOrder is the schema extension holding the position of a property in its
+// struct, since properties are marshalled in alphabetical order.
+const XOrder = "x-order"
+
 var fixPathRegex = regexp.MustCompile(":([a-zA-Z0-9_]+)")
 
 func New(title, description, version string, options ...Option) *Swagger {
-	swagger := &Swagger{Title: title, Description: description, Version: version, DocsUrl: "/docs", RedocUrl: "/redoc", OpenAPIUrl: "/openapi.json"}
+	swagger := &Swagger{Title: title, Description: description, Version: version, DocsUrl: "/docs", RedocUrl: "/redoc", OpenAPIUrl: "/openapi.json", AssetsUrl: "/docs-assets", TitleFormat: "{title} - {ui}", CSP: DefaultCSP, OperationIDFunc: MethodPathOperationID}
 	for _, option := range options {
 		option(swagger)
 	}
 	return swagger
 }
 func (swagger *Swagger) getSecurityRequirements(securities []security.ISecurity) *openapi3.SecurityRequirements {
+	if len(securities) == 0 {
+		return nil
+	}
 	securityRequirements := openapi3.NewSecurityRequirements()
-	for _, s := range securities {
-		provide := s.Provider()
-		swagger.OpenAPI.Components.SecuritySchemes[provide] = &openapi3.SecuritySchemeRef{
-			Value: s.Scheme(),
+	// securities are all required, composites add alternatives
+	for _, requirement := range security.Requirements(securities...) {
+		securityRequirement := openapi3.NewSecurityRequirement()
+		for _, s := range requirement {
+			provide := s.Provider()
+			scheme := s.Scheme()
+			if scheme == nil {
+				swagger.conflicts = append(swagger.conflicts, fmt.Errorf("security %T has no scheme", s))
+				continue
+			}
+			swagger.addSecurityScheme(provide, scheme)
+			scopes := securityRequirement[provide]
+			if scoped, ok := s.(security.Scoped); ok && hasScopes(scheme) {
+				for _, scope := range scoped.GetRequiredScopes() {
+					if !slices.Contains(scopes, scope) {
+						scopes = append(scopes, scope)
+					}
+				}
+			}
+			securityRequirement.Authenticate(provide, scopes...)
+		}
+		if !slices.ContainsFunc(*securityRequirements, func(r openapi3.SecurityRequirement) bool {
+			return maps.EqualFunc(r, securityRequirement, slices.Equal)
+		}) {
+			securityRequirements.With(securityRequirement)
 		}
-		securityRequirements.With(openapi3.NewSecurityRequirement().Authenticate(provide))
 	}
 	return securityRequirements
 }
+
+// hasScopes reports whether security requirements list scopes for scheme,
+// the lists of the other schemes are empty in OpenAPI 3.0.
+func hasScopes(scheme *openapi3.SecurityScheme) bool {
+	return scheme.Type == "oauth2" || scheme.Type == "openIdConnect"
+}
+
+// addSecurityScheme adds scheme to the components, a different scheme
+// already named provide is a conflict.
+func (swagger *Swagger) addSecurityScheme(provide string, scheme *openapi3.SecurityScheme) {
+	if current, ok := swagger.OpenAPI.Components.SecuritySchemes[provide]; ok {
+		a, _ := current.Value.MarshalJSON()
+		b, _ := scheme.MarshalJSON()
+		if !bytes.Equal(a, b) {
+			swagger.conflicts = append(swagger.conflicts, fmt.Errorf("security scheme %q is defined twice: %s and %s", provide, a, b))
+		}
+		return
+	}
+	swagger.OpenAPI.Components.SecuritySchemes[provide] = &openapi3.SecuritySchemeRef{Value: scheme}
+}
 func (swagger *Swagger) getSchemaByType(t any, request bool) *openapi3.Schema {
 	var schema *openapi3.Schema
 	var m = float64(0)
@@ -204,6 +293,7 @@ func (swagger *Swagger) getRequestSchemaByModel(model any) *openapi3.Schema {
 			if err == nil {
 				fieldSchema.Format = formatTag.Name
 			}
+			setOrder(fieldSchema, len(schema.Properties))
 			schema.Properties[tag.Name] = openapi3.NewSchemaRef("", fieldSchema)
 		}
 		schema.Type = &openapi3.Types{openapi3.TypeObject}
@@ -319,6 +409,7 @@ func (swagger *Swagger) getResponseSchemaByModel(model any) *openapi3.Schema {
 				if err == nil {
 					fieldSchema.Format = formatTag.Name
 				}
+				setOrder(fieldSchema, len(schema.Properties))
 				schema.Properties[tag.Name] = openapi3.NewSchemaRef("", fieldSchema)
 			}
 		}
@@ -419,6 +510,10 @@ func (swagger *Swagger) getParamTypeByModel(model any, param string) openapi3.Pa
 		if err == nil {
 			parameter.Required = bindingTag.Name == "required"
 		}
+		// path parameters are always required
+		if parameter.In == openapi3.ParameterInPath {
+			parameter.Required = true
+		}
 		exampleTag, err := tags.Get(EXAMPLE)
 		if err == nil {
 			parameter.Example = exampleTag.Name
@@ -495,9 +590,49 @@ func (swagger *Swagger) getParametersByModel(model any) openapi3.Parameters {
 	return parameters
 }
 
-// /:id -> /{id}
+func setOrder(schema *openapi3.Schema, order int) {
+	if schema.Extensions == nil {
+		schema.Extensions = make(map[string]any)
+	}
+	schema.Extensions[XOrder] = order
+}
+
+// sortedTags returns tags sorted and without duplicates, which come from
+// group tags being added to the tags of their routers.
+func sortedTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	return slices.Compact(slices.Sorted(slices.Values(tags)))
+}
+
+// /:id -> /{id}, under PathPrefix
 func (swagger *Swagger) fixPath(path string) string {
-	return fixPathRegex.ReplaceAllString(path, "{$1}")
+	return swagger.PathPrefix + fixPathRegex.ReplaceAllString(path, "{$1}")
+}
+
+// visitSchema calls visit for ref and every schema nested in it, once each.
+func visitSchema(ref *openapi3.SchemaRef, where string, visited map[*openapi3.Schema]bool, visit func(schema *openapi3.Schema, where string)) {
+	if ref == nil || ref.Value == nil || visited[ref.Value] {
+		return
+	}
+	schema := ref.Value
+	visited[schema] = true
+	visit(schema, where)
+	for _, item := range schema.AllOf {
+		visitSchema(item, where, visited, visit)
+	}
+	for _, item := range schema.OneOf {
+		visitSchema(item, where, visited, visit)
+	}
+	for _, item := range schema.AnyOf {
+		visitSchema(item, where, visited, visit)
+	}
+	for name, property := range schema.Properties {
+		visitSchema(property, where+"."+name, visited, visit)
+	}
+	visitSchema(schema.Items, where+"[]", visited, visit)
+	visitSchema(schema.AdditionalProperties.Schema, where+"{}", visited, visit)
 }
 
 func (swagger *Swagger) hasSchemaBody(requestBody *openapi3.RequestBodyRef) bool {
@@ -517,16 +652,18 @@ func (swagger *Swagger) hasSchemaBody(requestBody *openapi3.RequestBodyRef) bool
 
 func (swagger *Swagger) getPaths() *openapi3.Paths {
 	paths := &openapi3.Paths{Extensions: make(map[string]any)}
-	for path, m := range swagger.Routers {
+	for _, path := range slices.Sorted(maps.Keys(swagger.Routers)) {
+		m := swagger.Routers[path]
 		pathItem := &openapi3.PathItem{}
-		for method, r := range m {
+		for _, method := range slices.Sorted(maps.Keys(m)) {
+			r := m[method]
 			if r.Exclude {
 				continue
 			}
 			model := r.Model
 			operation := &openapi3.Operation{
-				Tags:        r.Tags,
-				OperationID: r.OperationID,
+				Tags:        sortedTags(r.Tags),
+				OperationID: swagger.getOperationID(method, path, r),
 				Summary:     r.Summary,
 				Description: r.Description,
 				Deprecated:  r.Deprecated,
@@ -534,12 +671,18 @@ func (swagger *Swagger) getPaths() *openapi3.Paths {
 				Parameters:  swagger.getParametersByModel(model),
 				Security:    swagger.getSecurityRequirements(r.Securities),
 			}
-			body := reflect.ValueOf(model).FieldByName("Body")
-			if body.IsValid() {
-				bodyValue := body.Interface()
-				requestBody := swagger.getRequestBodyByModel(bodyValue, r.RequestContentType)
-				if swagger.hasSchemaBody(requestBody) {
-					operation.RequestBody = requestBody
+			if r.Public && operation.Security == nil {
+				// override the security of the document
+				operation.Security = openapi3.NewSecurityRequirements()
+			}
+			if model != nil {
+				body := reflect.ValueOf(model).FieldByName("Body")
+				if body.IsValid() {
+					bodyValue := body.Interface()
+					requestBody := swagger.getRequestBodyByModel(bodyValue, r.RequestContentType)
+					if swagger.hasSchemaBody(requestBody) {
+						operation.RequestBody = requestBody
+					}
 				}
 			}
 
@@ -585,7 +728,15 @@ func (swagger *Swagger) BuildOpenAPI() {
 		Servers:    swagger.Servers,
 		Components: &components,
 	}
+	swagger.conflicts = nil
+	if requirements := swagger.getSecurityRequirements(swagger.Securities); requirements != nil {
+		swagger.OpenAPI.Security = *requirements
+	}
 	swagger.OpenAPI.Paths = swagger.getPaths()
+	swagger.OpenAPI.Tags = swagger.getTags(swagger.OpenAPI.Paths)
+	if len(swagger.TagGroups) != 0 {
+		swagger.OpenAPI.Extensions = map[string]any{XTagGroups: swagger.TagGroups}
+	}
 }
 
 func (swagger *Swagger) MarshalJSON() ([]byte, error) {
@@ -593,7 +744,11 @@ func (swagger *Swagger) MarshalJSON() ([]byte, error) {
 }
 
 func (swagger *Swagger) MarshalYAML() ([]byte, error) {
-	b, err := swagger.OpenAPI.MarshalJSON()
+	return marshalYAML(swagger.OpenAPI)
+}
+
+func marshalYAML(doc json.Marshaler) ([]byte, error) {
+	b, err := doc.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
@@ -628,6 +783,82 @@ func (swagger *Swagger) WithOpenAPIUrl(url string) *Swagger {
 	OpenAPIUrl(url)(swagger)
 	return swagger
 }
+func (swagger *Swagger) WithSwaggerUrl(url string) *Swagger {
+	SwaggerUrl(url)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithOperationIDs(f OperationIDFunc) *Swagger {
+	OperationIDs(f)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithAssetsUrl(url string) *Swagger {
+	AssetsUrl(url)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithCDN() *Swagger {
+	CDN()(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithUI(ui *UI) *Swagger {
+	DocUI(ui)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithTemplates(fsys fs.FS) *Swagger {
+	Templates(fsys)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithTitleFormat(format string) *Swagger {
+	TitleFormat(format)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithLogo(url string) *Swagger {
+	Logo(url)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithFavicon(url string) *Swagger {
+	Favicon(url)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithCustomCSS(css string) *Swagger {
+	CustomCSS(css)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithCustomJS(js string) *Swagger {
+	CustomJS(js)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithCSP(policy string) *Swagger {
+	CSP(policy)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithOAuth(config *OAuthConfig) *Swagger {
+	OAuth(config)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithDocsSecurity(securities ...security.ISecurity) *Swagger {
+	DocsSecurity(securities...)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithDocsHandlers(handlers ...gin.HandlerFunc) *Swagger {
+	DocsHandlers(handlers...)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithDisableDocs() *Swagger {
+	DisableDocs()(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithSecurity(securities ...security.ISecurity) *Swagger {
+	Security(securities...)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithTags(tags ...*openapi3.Tag) *Swagger {
+	Tags(tags...)(swagger)
+	return swagger
+}
+func (swagger *Swagger) WithTagGroups(groups ...TagGroup) *Swagger {
+	TagGroups(groups...)(swagger)
+	return swagger
+}
 func (swagger *Swagger) WithTermsOfService(termsOfService string) *Swagger {
 	TermsOfService(termsOfService)(swagger)
 	return swagger
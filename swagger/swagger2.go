@@ -0,0 +1,222 @@
+package swagger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/goccy/go-json"
+)
+
+// Swagger converts the built OpenAPI 3 document into a Swagger 2.0 document.
+// Constructs that can't be expressed in 2.0 are dropped from the result and
+// reported as warnings instead of producing an invalid document.
+func (swagger *Swagger) Swagger() (*openapi2.T, []string, error) {
+	if swagger.OpenAPI == nil {
+		swagger.BuildOpenAPI()
+	}
+	// openapi2conv mutates its input, so work on a copy of the document.
+	data, err := swagger.OpenAPI.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	doc := &openapi3.T{}
+	if err = json.Unmarshal(data, doc); err != nil {
+		return nil, nil, err
+	}
+	warnings := downgrade(doc)
+	doc2, err := openapi2conv.FromV3(doc)
+	if err != nil {
+		return nil, warnings, err
+	}
+	return doc2, warnings, nil
+}
+
+func (swagger *Swagger) MarshalSwaggerJSON() ([]byte, error) {
+	doc, _, err := swagger.Swagger()
+	if err != nil {
+		return nil, err
+	}
+	return doc.MarshalJSON()
+}
+
+func (swagger *Swagger) MarshalSwaggerYAML() ([]byte, error) {
+	doc, _, err := swagger.Swagger()
+	if err != nil {
+		return nil, err
+	}
+	return marshalYAML(doc)
+}
+
+// downgrade strips everything from doc that has no Swagger 2.0 counterpart.
+func downgrade(doc *openapi3.T) []string {
+	var warnings []string
+	warn := func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+
+	if len(doc.Servers) > 1 {
+		warn("only the first of %d servers is used as host and basePath", len(doc.Servers))
+	}
+	for _, server := range doc.Servers {
+		if len(server.Variables) != 0 {
+			warn("server %q: variables are not supported", server.URL)
+		}
+	}
+
+	removed := make(map[string]bool)
+	for name, ref := range doc.Components.SecuritySchemes {
+		if ref.Value == nil {
+			continue
+		}
+		switch {
+		case ref.Value.Type == "openIdConnect":
+			warn("security scheme %q: openIdConnect is not supported, scheme dropped", name)
+			removed[name] = true
+			delete(doc.Components.SecuritySchemes, name)
+		case ref.Value.Type == "apiKey" && ref.Value.In == openapi3.ParameterInCookie:
+			warn("security scheme %q: cookie apiKey is not supported, scheme dropped", name)
+			removed[name] = true
+			delete(doc.Components.SecuritySchemes, name)
+		case ref.Value.Type == "http" && ref.Value.Scheme != "basic":
+			warn("security scheme %q: http %s is exported as an Authorization header apiKey", name, ref.Value.Scheme)
+		case ref.Value.Type == "oauth2" && ref.Value.Flows != nil:
+			flows := ref.Value.Flows
+			count := 0
+			for _, flow := range []*openapi3.OAuthFlow{flows.Implicit, flows.AuthorizationCode, flows.Password, flows.ClientCredentials} {
+				if flow != nil {
+					count++
+				}
+			}
+			if count > 1 {
+				warn("security scheme %q: only one oauth2 flow is supported", name)
+			}
+		}
+	}
+	security := doc.Security
+	doc.Security = nil
+	if kept := withoutSchemes(security, removed, "document", warn); kept != nil {
+		doc.Security = *kept
+	}
+
+	visited := make(map[*openapi3.Schema]bool)
+	for name, ref := range doc.Components.Schemas {
+		downgradeSchema(ref, "#/components/schemas/"+name, visited, warn)
+	}
+	for path, pathItem := range doc.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			where := method + " " + path
+			if operation.Security != nil {
+				operation.Security = withoutSchemes(*operation.Security, removed, where, warn)
+			}
+
+			parameters := operation.Parameters[:0]
+			for _, parameter := range operation.Parameters {
+				if parameter.Value == nil {
+					parameters = append(parameters, parameter)
+					continue
+				}
+				if parameter.Value.In == openapi3.ParameterInCookie {
+					warn("%s: cookie parameter %q is not supported, parameter dropped", where, parameter.Value.Name)
+					continue
+				}
+				downgradeSchema(parameter.Value.Schema, where+" parameter "+parameter.Value.Name, visited, warn)
+				parameters = append(parameters, parameter)
+			}
+			operation.Parameters = parameters
+
+			if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+				content := operation.RequestBody.Value.Content
+				if len(content) > 1 {
+					warn("%s: request body has %d media types, only one is kept", where, len(content))
+				}
+				for mime, mediaType := range content {
+					downgradeSchema(mediaType.Schema, where+" request body "+mime, visited, warn)
+				}
+			}
+
+			if operation.Responses == nil || operation.Responses.Len() == 0 {
+				warn("%s: no responses declared, added a default response", where)
+				operation.Responses = openapi3.NewResponses()
+			}
+			for status, response := range operation.Responses.Map() {
+				if response.Value == nil {
+					continue
+				}
+				// description is required in 2.0 and omitted when empty
+				if response.Value.Description == nil || *response.Value.Description == "" {
+					description := status + " response"
+					response.Value.Description = &description
+				}
+				for mime, mediaType := range response.Value.Content {
+					if mime != binding.MIMEJSON {
+						warn("%s: response %s: only %s schemas are exported, %s dropped", where, status, binding.MIMEJSON, mime)
+						continue
+					}
+					downgradeSchema(mediaType.Schema, where+" response "+status, visited, warn)
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+func downgradeSchema(ref *openapi3.SchemaRef, where string, visited map[*openapi3.Schema]bool, warn func(string, ...any)) {
+	visitSchema(ref, where, visited, func(schema *openapi3.Schema, where string) {
+		if len(schema.OneOf) != 0 {
+			warn("%s: oneOf is not supported, alternatives dropped", where)
+			schema.OneOf = nil
+		}
+		if len(schema.AnyOf) != 0 {
+			warn("%s: anyOf is not supported, alternatives dropped", where)
+			schema.AnyOf = nil
+		}
+		if schema.Not != nil {
+			warn("%s: not is not supported, constraint dropped", where)
+			schema.Not = nil
+		}
+		if schema.Discriminator != nil {
+			warn("%s: discriminator mapping is not supported, mapping dropped", where)
+			schema.Discriminator = nil
+		}
+		if schema.Type != nil && len(*schema.Type) > 1 {
+			warn("%s: multiple types %s are not supported, only %s kept", where, strings.Join(*schema.Type, ", "), (*schema.Type)[0])
+			schema.Type = &openapi3.Types{(*schema.Type)[0]}
+		}
+	})
+}
+
+// withoutSchemes returns requirements without the removed schemes, or nil
+// when all of them referenced removed schemes: an empty list would make the
+// operation public.
+func withoutSchemes(requirements openapi3.SecurityRequirements, removed map[string]bool, where string, warn func(string, ...any)) *openapi3.SecurityRequirements {
+	if len(removed) == 0 || requirements == nil {
+		return &requirements
+	}
+	result := openapi3.SecurityRequirements{}
+	for _, requirement := range requirements {
+		kept := openapi3.SecurityRequirement{}
+		dropped := false
+		for name, scopes := range requirement {
+			if removed[name] {
+				dropped = true
+				continue
+			}
+			kept[name] = scopes
+		}
+		// A requirement that only referenced dropped schemes would turn
+		// into an anonymous one, so drop it altogether.
+		if dropped && len(kept) == 0 {
+			continue
+		}
+		result = append(result, kept)
+	}
+	if len(result) == 0 && len(requirements) != 0 {
+		warn("%s: security only uses unsupported schemes, security left unset", where)
+		return nil
+	}
+	return &result
+}
@@ -0,0 +1,106 @@
+package swagger
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func newDowngradeDoc() *openapi3.T {
+	ok := &openapi3.ResponseRef{Value: openapi3.NewResponse().WithDescription("ok")}
+	openID := openapi3.SecurityRequirement{"OpenIDAuth": {}}
+	bearer := openapi3.SecurityRequirement{"BearerAuth": {}}
+	onlyOpenID := openapi3.SecurityRequirements{openID}
+	either := openapi3.SecurityRequirements{openID, bearer}
+	body := openapi3.NewRequestBody().WithJSONSchema(openapi3.NewOneOfSchema(openapi3.NewStringSchema(), openapi3.NewIntegerSchema()))
+	return &openapi3.T{
+		OpenAPI: "3.0.0",
+		Info:    &openapi3.Info{Title: "Test", Version: "1.0.0"},
+		Components: &openapi3.Components{SecuritySchemes: openapi3.SecuritySchemes{
+			"OpenIDAuth": {Value: &openapi3.SecurityScheme{Type: "openIdConnect", OpenIdConnectUrl: "https://issuer/.well-known/openid-configuration"}},
+			"BearerAuth": {Value: &openapi3.SecurityScheme{Type: "http", Scheme: "bearer"}},
+		}},
+		Security: onlyOpenID,
+		Paths: openapi3.NewPaths(
+			openapi3.WithPath("/x", &openapi3.PathItem{Post: &openapi3.Operation{
+				Parameters: openapi3.Parameters{
+					{Value: openapi3.NewCookieParameter("session").WithSchema(openapi3.NewStringSchema())},
+					{Value: openapi3.NewQueryParameter("q").WithSchema(openapi3.NewStringSchema())},
+				},
+				RequestBody: &openapi3.RequestBodyRef{Value: body},
+				Security:    &onlyOpenID,
+				Responses:   openapi3.NewResponses(openapi3.WithStatus(200, ok)),
+			}}),
+			openapi3.WithPath("/y", &openapi3.PathItem{Get: &openapi3.Operation{
+				Security:  &either,
+				Responses: openapi3.NewResponses(openapi3.WithStatus(200, ok)),
+			}}),
+		),
+	}
+}
+
+func TestDowngradeWarnings(t *testing.T) {
+	warnings := downgrade(newDowngradeDoc())
+	for _, want := range []string{
+		`security scheme "OpenIDAuth": openIdConnect is not supported, scheme dropped`,
+		`security scheme "BearerAuth": http bearer is exported as an Authorization header apiKey`,
+		`document: security only uses unsupported schemes, security left unset`,
+		`POST /x: security only uses unsupported schemes, security left unset`,
+		`POST /x: cookie parameter "session" is not supported, parameter dropped`,
+		`POST /x request body application/json: oneOf is not supported, alternatives dropped`,
+	} {
+		if !slices.Contains(warnings, want) {
+			t.Errorf("warnings don't contain %q:\n%s", want, strings.Join(warnings, "\n"))
+		}
+	}
+	if slices.ContainsFunc(warnings, func(w string) bool { return strings.HasPrefix(w, "GET /y: security") }) {
+		t.Errorf("GET /y keeps BearerAuth, but a security warning is reported:\n%s", strings.Join(warnings, "\n"))
+	}
+}
+
+func TestSwagger2(t *testing.T) {
+	swagger := New("Test", "", "1.0.0")
+	swagger.OpenAPI = newDowngradeDoc()
+	doc, _, err := swagger.Swagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Swagger != "2.0" {
+		t.Errorf("swagger = %q, want 2.0", doc.Swagger)
+	}
+	if _, ok := doc.SecurityDefinitions["OpenIDAuth"]; ok {
+		t.Error("OpenIDAuth is in the security definitions, want it dropped")
+	}
+	if scheme := doc.SecurityDefinitions["BearerAuth"]; scheme == nil || scheme.Type != "apiKey" || scheme.In != "header" || scheme.Name != "Authorization" {
+		t.Errorf("BearerAuth = %+v, want an Authorization header apiKey", scheme)
+	}
+	if len(doc.Security) != 0 {
+		t.Errorf("document security = %v, want unset", doc.Security)
+	}
+
+	x := doc.Paths["/x"].Post
+	if x.Security != nil {
+		t.Errorf("POST /x security = %v, want unset rather than public", *x.Security)
+	}
+	for _, parameter := range x.Parameters {
+		if parameter.In == "cookie" {
+			t.Errorf("POST /x keeps the cookie parameter %q", parameter.Name)
+		}
+	}
+	if !slices.ContainsFunc(x.Parameters, func(p *openapi2.Parameter) bool { return p.In == "body" }) {
+		t.Error("POST /x has no body parameter")
+	}
+
+	y := doc.Paths["/y"].Get
+	if y.Security == nil || len(*y.Security) != 1 || (*y.Security)[0]["BearerAuth"] == nil {
+		t.Errorf("GET /y security = %v, want BearerAuth only", y.Security)
+	}
+
+	// the document built by the app is left untouched
+	if len(swagger.OpenAPI.Components.SecuritySchemes) != 2 {
+		t.Error("converting changed the OpenAPI 3 document")
+	}
+}
@@ -0,0 +1,47 @@
+package swagger
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// XTagGroups is the ReDoc extension grouping tags in the navigation.
+const XTagGroups = "x-tagGroups"
+
+// TagGroup is an entry of x-tagGroups.
+type TagGroup struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// AddTag declares tag, or updates the tag declared with the same name.
+// Declared tags are listed in the docs in the order of declaration.
+func (swagger *Swagger) AddTag(tag *openapi3.Tag) {
+	if i := slices.IndexFunc(swagger.Tags, func(t *openapi3.Tag) bool { return t.Name == tag.Name }); i != -1 {
+		swagger.Tags[i] = tag
+		return
+	}
+	swagger.Tags = append(swagger.Tags, tag)
+}
+
+// getTags returns the declared tags followed by the undeclared tags used by
+// operations, sorted.
+func (swagger *Swagger) getTags(paths *openapi3.Paths) openapi3.Tags {
+	tags := slices.Clone(swagger.Tags)
+	used := make(map[string]bool)
+	for _, pathItem := range paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			for _, tag := range operation.Tags {
+				if tags.Get(tag) == nil {
+					used[tag] = true
+				}
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(used)) {
+		tags = append(tags, &openapi3.Tag{Name: name})
+	}
+	return tags
+}
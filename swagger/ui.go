@@ -0,0 +1,67 @@
+package swagger
+
+import "slices"
+
+// Names of the built-in documentation UIs.
+const (
+	SwaggerUI = "swagger"
+	Redoc     = "redoc"
+	Scalar    = "scalar"
+	RapiDoc   = "rapidoc"
+	Elements  = "elements"
+)
+
+// UI is a documentation page rendering the docs served at OpenAPIUrl.
+type UI struct {
+	// Name of the UI, the built-in ones are rendered by their own template.
+	Name string
+	// Url the page is served at, the page is disabled when empty.
+	Url string
+	// Template is the html/template source of the page, required for UIs
+	// other than the built-in ones.
+	Template string
+	// Options are passed to the page as JSON.
+	Options map[string]any
+}
+
+// GetUIs returns the enabled documentation UIs. Swagger UI and ReDoc are
+// configured by DocsUrl and RedocUrl, unless a UI with the same name is set.
+func (swagger *Swagger) GetUIs() []*UI {
+	uis := []*UI{
+		{Name: SwaggerUI, Url: swagger.DocsUrl, Options: swagger.SwaggerOptions},
+		{Name: Redoc, Url: swagger.RedocUrl, Options: swagger.RedocOptions},
+	}
+	for _, ui := range swagger.UIs {
+		if i := slices.IndexFunc(uis, func(u *UI) bool { return u.Name == ui.Name }); i != -1 {
+			uis[i] = ui
+		} else {
+			uis = append(uis, ui)
+		}
+	}
+	return slices.DeleteFunc(uis, func(ui *UI) bool { return ui.Url == "" })
+}
+
+// OAuthConfig configures the OAuth2 and OpenID Connect login of Swagger UI,
+// it's passed to ui.initOAuth.
+type OAuthConfig struct {
+	// ClientId is prefilled in the authorization popup.
+	ClientId string `json:"clientId,omitempty"`
+	// ClientSecret is prefilled in the authorization popup, never use it in
+	// production since it's visible to everyone reading the docs.
+	ClientSecret string `json:"clientSecret,omitempty"`
+	// Realm is added to the authorization url as the realm query parameter.
+	Realm string `json:"realm,omitempty"`
+	// AppName is added to the authorization url as the app_name parameter.
+	AppName string `json:"appName,omitempty"`
+	// Scopes are selected by default.
+	Scopes []string `json:"scopes,omitempty"`
+	// ScopeSeparator joins the scopes, a space by default.
+	ScopeSeparator string `json:"scopeSeparator,omitempty"`
+	// AdditionalQueryStringParams are added to the authorization and token urls.
+	AdditionalQueryStringParams map[string]string `json:"additionalQueryStringParams,omitempty"`
+	// UseBasicAuthenticationWithAccessCodeGrant sends the client credentials
+	// with basic auth to the token url.
+	UseBasicAuthenticationWithAccessCodeGrant bool `json:"useBasicAuthenticationWithAccessCodeGrant,omitempty"`
+	// UsePKCE enables PKCE for the authorization code flow.
+	UsePKCE bool `json:"usePkceWithAuthorizationCodeGrant,omitempty"`
+}
@@ -0,0 +1,160 @@
+package swagger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Validate checks the built document against the OpenAPI specification and
+// runs the swagin specific consistency checks, returning every problem found
+// joined into a single error.
+func (swagger *Swagger) Validate() error {
+	if swagger.OpenAPI == nil {
+		swagger.BuildOpenAPI()
+	}
+	var errs []error
+	errs = append(errs, swagger.validateOperationIDs()...)
+	errs = append(errs, swagger.validateRouters()...)
+	errs = append(errs, swagger.validateSecurity(swagger.OpenAPI.Security, "document")...)
+	errs = append(errs, swagger.validateTagGroups()...)
+	errs = append(errs, swagger.conflicts...)
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+	if err := swagger.OpenAPI.Validate(context.Background()); err != nil {
+		errs = append([]error{err}, errs...)
+	}
+	return errors.Join(errs...)
+}
+
+func (swagger *Swagger) validateOperationIDs() []error {
+	seen := make(map[string][]string)
+	for path, pathItem := range swagger.OpenAPI.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			if operation.OperationID != "" {
+				seen[operation.OperationID] = append(seen[operation.OperationID], method+" "+path)
+			}
+		}
+	}
+	var errs []error
+	for id, operations := range seen {
+		if len(operations) > 1 {
+			sort.Strings(operations)
+			errs = append(errs, fmt.Errorf("operation id %q is used by %s", id, strings.Join(operations, ", ")))
+		}
+	}
+	return errs
+}
+
+func (swagger *Swagger) validateRouters() []error {
+	var errs []error
+	for _, path := range slices.Sorted(maps.Keys(swagger.Routers)) {
+		m := swagger.Routers[path]
+		pathItem := swagger.OpenAPI.Paths.Find(swagger.fixPath(path))
+		if pathItem == nil {
+			continue
+		}
+		var pathParams []string
+		for _, match := range fixPathRegex.FindAllStringSubmatch(path, -1) {
+			pathParams = append(pathParams, match[1])
+		}
+		for _, method := range slices.Sorted(maps.Keys(m)) {
+			r := m[method]
+			operation := pathItem.GetOperation(method)
+			if r.Exclude || operation == nil {
+				continue
+			}
+			where := method + " " + path
+			var uriParams []string
+			for _, parameter := range operation.Parameters {
+				if parameter.Value != nil && parameter.Value.In == openapi3.ParameterInPath {
+					uriParams = append(uriParams, parameter.Value.Name)
+				}
+			}
+			for _, name := range pathParams {
+				if !slices.Contains(uriParams, name) {
+					errs = append(errs, fmt.Errorf("%s: path parameter %q has no matching `uri` field in URI", where, name))
+				}
+			}
+			for _, name := range uriParams {
+				if !slices.Contains(pathParams, name) {
+					errs = append(errs, fmt.Errorf("%s: URI field %q is not a parameter of the path", where, name))
+				}
+			}
+			errs = append(errs, validatePatterns(operation, where)...)
+			if operation.Security != nil {
+				errs = append(errs, swagger.validateSecurity(*operation.Security, where)...)
+			}
+		}
+	}
+	return errs
+}
+
+func validatePatterns(operation *openapi3.Operation, where string) []error {
+	var errs []error
+	visited := make(map[*openapi3.Schema]bool)
+	check := func(schema *openapi3.Schema, where string) {
+		if schema.Pattern == "" {
+			return
+		}
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid pattern %q: %w", where, schema.Pattern, err))
+		}
+	}
+	for _, parameter := range operation.Parameters {
+		if parameter.Value != nil {
+			visitSchema(parameter.Value.Schema, where+" parameter "+parameter.Value.Name, visited, check)
+		}
+	}
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		for mime, mediaType := range operation.RequestBody.Value.Content {
+			visitSchema(mediaType.Schema, where+" request body "+mime, visited, check)
+		}
+	}
+	if operation.Responses != nil {
+		for status, response := range operation.Responses.Map() {
+			if response.Value == nil {
+				continue
+			}
+			for _, mediaType := range response.Value.Content {
+				visitSchema(mediaType.Schema, where+" response "+status, visited, check)
+			}
+		}
+	}
+	return errs
+}
+
+func (swagger *Swagger) validateSecurity(requirements openapi3.SecurityRequirements, where string) []error {
+	var errs []error
+	for _, requirement := range requirements {
+		for name, scopes := range requirement {
+			ref, ok := swagger.OpenAPI.Components.SecuritySchemes[name]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: security requirement references unknown scheme %q", where, name))
+			} else if ref.Value != nil && !hasScopes(ref.Value) && len(scopes) != 0 {
+				errs = append(errs, fmt.Errorf("%s: security requirement lists scopes for %s scheme %q, only oauth2 and openIdConnect take scopes", where, ref.Value.Type, name))
+			}
+		}
+	}
+	return errs
+}
+
+func (swagger *Swagger) validateTagGroups() []error {
+	var errs []error
+	for _, group := range swagger.TagGroups {
+		for _, tag := range group.Tags {
+			if swagger.OpenAPI.Tags.Get(tag) == nil {
+				errs = append(errs, fmt.Errorf("tag group %q references unknown tag %q", group.Name, tag))
+			}
+		}
+	}
+	return errs
+}
@@ -2,15 +2,20 @@ package swagin
 
 import (
 	"embed"
-	"html/template"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
 	"net/http"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
-	"github.com/goccy/go-json"
 
 	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/security"
 	"github.com/x-research-team/swagin/swagger"
 )
 
@@ -23,7 +28,18 @@ type SwaGin struct {
 	Swagger        *swagger.Swagger
 	Routers        map[string]map[string]*router.Router
 	subApps        map[string]*SwaGin
+	parent         *SwaGin
+	mountPath      string
 	rootPath       string
+	middlewares    []gin.HandlerFunc
+	versions       []string
+	versionOf      func(c *gin.Context) string
+	versionHeader  string
+	versioned      []*router.Router
+	versionDocs    map[string]*swagger.Swagger
+	strict         bool
+	merge          bool
+	docs           gin.IRoutes
 	ErrorHandler   router.ErrorHandlerFunc
 	beforeInitFunc func()
 	afterInitFunc  func()
@@ -38,6 +54,21 @@ func Server(srv *http.Server) GinOption {
 	}
 }
 
+// Strict panic in Init when the built docs are invalid instead of logging
+func Strict() GinOption {
+	return func(g *SwaGin) {
+		g.strict = true
+	}
+}
+
+// MergeSubApps merge the docs of the mounted apps, recursively, into the docs
+// of the app, the sub apps still serve their own docs
+func MergeSubApps() GinOption {
+	return func(g *SwaGin) {
+		g.merge = true
+	}
+}
+
 func New(sw *swagger.Swagger, opts ...GinOption) *SwaGin {
 	f := &SwaGin{
 		Engine:  gin.New(),
@@ -50,35 +81,62 @@ func New(sw *swagger.Swagger, opts ...GinOption) *SwaGin {
 		opt(f)
 	}
 
-	f.SetHTMLTemplate(template.Must(template.ParseFS(templates, "templates/*.html")))
 	if sw != nil {
 		sw.Routers = f.Routers
 	}
 	return f
 }
 
+// Middlewares use middlewares for the routes of the app, including the ones
+// of its sub apps. The middlewares of the main app are used by its engine.
 func (g *SwaGin) Middlewares(middlewares ...gin.HandlerFunc) *SwaGin {
-	g.Engine.Use(middlewares...)
+	g.middlewares = append(g.middlewares, middlewares...)
+	if g.parent == nil {
+		g.Engine.Use(middlewares...)
+	}
 	return g
 }
 
+// WithErrorHandler handle the errors binding the request models, instead of
+// panicking, the sub apps without an error handler use it too
 func (g *SwaGin) WithErrorHandler(handler router.ErrorHandlerFunc) *SwaGin {
 	g.ErrorHandler = handler
 	return g
 }
 
+// Mount serves app and its own sub apps under path, mounts compose to any
+// depth since the root paths are concatenated.
 func (g *SwaGin) Mount(path string, app *SwaGin) {
-	app.rootPath = path
-	app.Engine = g.Engine
-	if app.ErrorHandler == nil {
-		app.ErrorHandler = g.ErrorHandler
-	}
-	app.Swagger.Servers = append(app.Swagger.Servers, &openapi3.Server{
-		URL: path,
-	})
+	app.parent = g
+	app.mountPath = path
+	app.mount()
 	g.subApps[path] = app
 }
 
+// mount shares the engine of the main app with the app and its sub apps.
+func (g *SwaGin) mount() {
+	g.rootPath = g.parent.rootPath + g.mountPath
+	g.Engine = g.parent.Engine
+	for _, app := range g.subApps {
+		app.mount()
+	}
+}
+
+// group is the router group of the app, using the middlewares of the
+// mounted apps from the main app down to the app.
+func (g *SwaGin) group() *gin.RouterGroup {
+	var handlers []gin.HandlerFunc
+	for app := g; app.parent != nil; app = app.parent {
+		handlers = append(slices.Clone(app.middlewares), handlers...)
+	}
+	if g.ErrorHandler != nil {
+		handlers = append([]gin.HandlerFunc{func(c *gin.Context) {
+			c.Set(router.ErrorHandlerKey, g.ErrorHandler)
+		}}, handlers...)
+	}
+	return g.Engine.Group("", handlers...)
+}
+
 func (g *SwaGin) Group(path string, options ...Option) *Group {
 	group := &Group{
 		SwaGin: g,
@@ -93,6 +151,10 @@ func (g *SwaGin) Group(path string, options ...Option) *Group {
 func (g *SwaGin) Handle(path string, method string, r *router.Router) {
 	r.Method = method
 	r.Path = path
+	if len(g.versions) != 0 {
+		g.versioned = append(g.versioned, r)
+		return
+	}
 	if g.Routers[path] == nil {
 		g.Routers[path] = make(map[string]*router.Router)
 	}
@@ -127,93 +189,263 @@ func (g *SwaGin) OPTIONS(path string, r *router.Router) {
 	g.Handle(path, http.MethodOptions, r)
 }
 
-func (g *SwaGin) init() {
-	g.initRouters()
+func (g *SwaGin) init() error {
+	for app := g.parent; g.ErrorHandler == nil && app != nil; app = app.parent {
+		g.ErrorHandler = app.ErrorHandler
+	}
+	if g.Swagger != nil && len(g.Swagger.Securities) == 0 {
+		g.Swagger.Securities = g.securities()
+	}
+	routes := g.group()
+	var versions map[string]map[string]map[string]*router.Router
+	var errs []error
+	if len(g.versions) != 0 {
+		versions, errs = g.versionRouters()
+		g.initVersions(routes, versions)
+	} else {
+		g.initRouters(routes, "", g.Routers)
+	}
 	if g.Swagger == nil {
-		return
+		return errors.Join(errs...)
 	}
 	gin.DisableBindValidation()
-	g.Engine.GET(g.fullPath(g.Swagger.OpenAPIUrl), func(c *gin.Context) {
-		if strings.HasSuffix(g.Swagger.OpenAPIUrl, ".yml") ||
-			strings.HasSuffix(g.Swagger.OpenAPIUrl, ".yaml") {
-			y, err := g.Swagger.MarshalYAML()
-			if err != nil {
-				c.JSON(http.StatusInternalServerError, map[string]string{"status": err.Error()})
-			}
-			c.String(http.StatusOK, string(y))
+	if len(g.versions) != 0 {
+		errs = append(errs, g.buildVersions(versions)...)
+	} else {
+		g.buildOpenAPI()
+	}
+	if g.merge {
+		errs = append(errs, g.mergeSubApps(g.Swagger.OpenAPI, "", "")...)
+	}
+	if g.Swagger.DisableDocs {
+		return errors.Join(append(errs, g.Swagger.Validate())...)
+	}
+	g.docs = routes.Group("", g.Swagger.GetDocsHandlers()...)
+	g.initAssets()
+	if handler, err := specHandler(g.Swagger.OpenAPIUrl, g.Swagger.MarshalJSON, g.Swagger.MarshalYAML); err != nil {
+		errs = append(errs, err)
+	} else {
+		g.docs.GET(g.fullPath(g.Swagger.OpenAPIUrl), handler)
+	}
+	for _, version := range g.versions {
+		sw := g.versionDocs[version]
+		if handler, err := specHandler(sw.OpenAPIUrl, sw.MarshalJSON, sw.MarshalYAML); err != nil {
+			errs = append(errs, fmt.Errorf("version %s: %w", version, err))
 		} else {
-			c.JSON(http.StatusOK, g.Swagger)
+			g.docs.GET(g.versionUrl(version), handler)
 		}
-	})
-	g.Engine.GET(g.fullPath(g.Swagger.DocsUrl), func(c *gin.Context) {
-		options := `{}`
-		if g.Swagger.SwaggerOptions != nil {
-			data, err := json.Marshal(g.Swagger.SwaggerOptions)
-			if err != nil {
-				panic(err)
-			}
-			options = string(data)
+	}
+	g.initUIs()
+	if g.Swagger.SwaggerUrl != "" {
+		if err := g.initSwagger2(); err != nil {
+			errs = append(errs, err)
 		}
-		c.HTML(http.StatusOK, "swagger.html", gin.H{
-			"openapi_url":     g.fullPath(g.Swagger.OpenAPIUrl),
-			"title":           g.Swagger.Title,
-			"swagger_options": options,
+	}
+	return errors.Join(append(errs, g.Swagger.Validate())...)
+}
+
+func (g *SwaGin) buildOpenAPI() {
+	g.buildSwagger(g.Swagger, "")
+}
+
+// buildSwagger builds the docs of routes served under prefix, which is part
+// of the paths so that merging the docs into another keeps it.
+func (g *SwaGin) buildSwagger(sw *swagger.Swagger, prefix string) {
+	sw.RootPath = g.rootPath
+	sw.PathPrefix = prefix
+	sw.BuildOpenAPI()
+	if g.parent != nil {
+		sw.OpenAPI.Servers = append(slices.Clone(sw.Servers), &openapi3.Server{
+			URL: g.rootPath,
 		})
-	})
-	g.Engine.GET(g.fullPath(g.Swagger.RedocUrl), func(c *gin.Context) {
-		options := `{}`
-		if g.Swagger.RedocOptions != nil {
-			data, err := json.Marshal(g.Swagger.RedocOptions)
-			if err != nil {
-				panic(err)
-			}
-			options = string(data)
+	}
+}
+
+// mergeSubApps merges the docs of the sub apps and their own sub apps, which
+// are built before the app, into doc, under prefix and with tags namespaced by the titles of the apps.
+func (g *SwaGin) mergeSubApps(doc *openapi3.T, prefix, namespace string) []error {
+	var errs []error
+	for _, path := range slices.Sorted(maps.Keys(g.subApps)) {
+		app := g.subApps[path]
+		if app.Swagger == nil {
+			continue
 		}
-		c.HTML(http.StatusOK, "redoc.html", gin.H{
-			"openapi_url":   g.fullPath(g.Swagger.OpenAPIUrl),
-			"title":         g.Swagger.Title,
-			"redoc_options": options,
-		})
+		ns := app.Swagger.Title
+		if namespace != "" {
+			ns = namespace + "/" + ns
+		}
+		if err := swagger.Merge(doc, app.Swagger.OpenAPI, prefix+path, ns); err != nil {
+			errs = append(errs, fmt.Errorf("merge %s: %w", prefix+path, err))
+		}
+		errs = append(errs, app.mergeSubApps(doc, prefix+path, ns)...)
+	}
+	return errs
+}
+
+// initSwagger2 serves the Swagger 2.0 form of the docs, logging what the
+// conversion drops.
+func (g *SwaGin) initSwagger2() error {
+	doc, warnings, err := g.Swagger.Swagger()
+	if err != nil {
+		return fmt.Errorf("swagger 2.0: %w", err)
+	}
+	for _, warning := range warnings {
+		log.Printf("swagin: swagger 2.0: %s", warning)
+	}
+	handler, err := specHandler(g.Swagger.SwaggerUrl, doc.MarshalJSON, func() ([]byte, error) {
+		return g.Swagger.MarshalSwaggerYAML()
 	})
-	g.Swagger.BuildOpenAPI()
-}
-func (g *SwaGin) initRouters() {
-	for path, m := range g.Routers {
-		path = g.fullPath(path)
-		for method, r := range m {
-			handlers := r.GetHandlers()
-			if method == http.MethodGet {
-				g.Engine.GET(path, handlers...)
-			} else if method == http.MethodPost {
-				g.Engine.POST(path, handlers...)
-			} else if method == http.MethodHead {
-				g.Engine.HEAD(path, handlers...)
-			} else if method == http.MethodPatch {
-				g.Engine.PATCH(path, handlers...)
-			} else if method == http.MethodDelete {
-				g.Engine.DELETE(path, handlers...)
-			} else if method == http.MethodPut {
-				g.Engine.PUT(path, handlers...)
-			} else if method == http.MethodOptions {
-				g.Engine.OPTIONS(path, handlers...)
-			} else {
-				g.Engine.Any(path, handlers...)
-			}
+	if err != nil {
+		return fmt.Errorf("swagger 2.0: %w", err)
+	}
+	g.docs.GET(g.fullPath(g.Swagger.SwaggerUrl), handler)
+	return nil
+}
+func (g *SwaGin) initRouters(routes gin.IRoutes, prefix string, routers map[string]map[string]*router.Router) {
+	for _, path := range slices.Sorted(maps.Keys(routers)) {
+		m := routers[path]
+		for _, method := range slices.Sorted(maps.Keys(m)) {
+			handle(routes, method, g.fullPath(prefix+path), g.handlers(m[method])...)
+		}
+	}
+}
+
+// handlers returns the handlers of r, the default securities of the app are
+// the ones of routes without securities unless public.
+func (g *SwaGin) handlers(r *router.Router) []gin.HandlerFunc {
+	securities := g.securities()
+	if r.Public || len(r.Securities) != 0 || len(securities) == 0 {
+		return r.GetHandlers()
+	}
+	return append([]gin.HandlerFunc{security.All(securities...).Authorize}, r.GetHandlers()...)
+}
+
+// securities returns the default securities of the app, the ones of its
+// docs or of the closest mounted app having some.
+func (g *SwaGin) securities() []security.ISecurity {
+	for app := g; app != nil; app = app.parent {
+		if app.Swagger != nil && len(app.Swagger.Securities) != 0 {
+			return app.Swagger.Securities
 		}
 	}
+	return nil
+}
+
+func handle(routes gin.IRoutes, method, path string, handlers ...gin.HandlerFunc) {
+	if method == http.MethodGet {
+		routes.GET(path, handlers...)
+	} else if method == http.MethodPost {
+		routes.POST(path, handlers...)
+	} else if method == http.MethodHead {
+		routes.HEAD(path, handlers...)
+	} else if method == http.MethodPatch {
+		routes.PATCH(path, handlers...)
+	} else if method == http.MethodDelete {
+		routes.DELETE(path, handlers...)
+	} else if method == http.MethodPut {
+		routes.PUT(path, handlers...)
+	} else if method == http.MethodOptions {
+		routes.OPTIONS(path, handlers...)
+	} else {
+		routes.Any(path, handlers...)
+	}
 }
+
+// Init registers the routes and docs, logging validation errors of the
+// built docs, or panicking with them in strict mode.
 func (g *SwaGin) Init() {
+	if err := g.InitE(); err != nil {
+		if g.strict {
+			panic(err)
+		}
+		log.Printf("swagin: invalid openapi document: %v", err)
+	}
+}
+
+// InitE registers the routes and docs like Init, but returns the validation
+// errors of the built docs of the app and its sub apps.
+//
+// Apps are initialised depth first: the BeforeInit hook of an app runs, then
+// its sub apps are initialised in the order of their paths, then its own
+// routes and docs are registered and finally its AfterInit hook runs.
+func (g *SwaGin) InitE() error {
+	errs := g.initApps()
+	errs = append(errs, g.validateOperationIDs()...)
+	return errors.Join(errs...)
+}
+
+func (g *SwaGin) initApps() []error {
 	if g.beforeInitFunc != nil {
 		g.beforeInitFunc()
 	}
-	g.init()
+	var errs []error
+	for _, path := range slices.Sorted(maps.Keys(g.subApps)) {
+		errs = append(errs, g.subApps[path].initApps()...)
+	}
+	if err := g.init(); err != nil {
+		if g.parent != nil {
+			err = fmt.Errorf("%s: %w", g.rootPath, err)
+		}
+		errs = append(errs, err)
+	}
 	if g.afterInitFunc != nil {
 		g.afterInitFunc()
 	}
-	for _, s := range g.subApps {
-		s.init()
+	return errs
+}
+
+// validateOperationIDs reports operationIds shared by the docs of the app
+// and its sub apps, duplicates within one docs are reported by its Validate.
+func (g *SwaGin) validateOperationIDs() []error {
+	if g.merge {
+		// the merged docs already hold the operations of every app
+		return nil
+	}
+	apps := g.apps()
+	seen := make(map[string]map[*SwaGin][]string)
+	for _, app := range apps {
+		if app.Swagger == nil || app.Swagger.OpenAPI == nil {
+			continue
+		}
+		for path, pathItem := range app.Swagger.OpenAPI.Paths.Map() {
+			for method, operation := range pathItem.Operations() {
+				if operation.OperationID == "" {
+					continue
+				}
+				if seen[operation.OperationID] == nil {
+					seen[operation.OperationID] = make(map[*SwaGin][]string)
+				}
+				seen[operation.OperationID][app] = append(seen[operation.OperationID][app], method+" "+app.fullPath(path))
+			}
+		}
 	}
+	var errs []error
+	for id, byApp := range seen {
+		if len(byApp) < 2 {
+			continue
+		}
+		var operations []string
+		for _, ops := range byApp {
+			operations = append(operations, ops...)
+		}
+		sort.Strings(operations)
+		errs = append(errs, fmt.Errorf("operation id %q is used across apps by %s", id, strings.Join(operations, ", ")))
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+	return errs
+}
+
+// apps returns the app and its sub apps, recursively.
+func (g *SwaGin) apps() []*SwaGin {
+	apps := []*SwaGin{g}
+	for _, path := range slices.Sorted(maps.Keys(g.subApps)) {
+		apps = append(apps, g.subApps[path].apps()...)
+	}
+	return apps
 }
+
 func (g *SwaGin) fullPath(path string) string {
 	return g.rootPath + path
 }
@@ -228,6 +460,19 @@ func (g *SwaGin) AfterInit(f func()) {
 
 func (g *SwaGin) StartGraceful(addr ...string) error {
 	g.Init()
+	return g.listen(addr...)
+}
+
+// StartGracefulE starts the server like StartGraceful, but refuses to start
+// when the built docs are invalid.
+func (g *SwaGin) StartGracefulE(addr ...string) error {
+	if err := g.InitE(); err != nil {
+		return err
+	}
+	return g.listen(addr...)
+}
+
+func (g *SwaGin) listen(addr ...string) error {
 	if g.srv == nil {
 		g.srv = &http.Server{
 			Addr: func() string {
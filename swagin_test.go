@@ -0,0 +1,149 @@
+package swagin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/security"
+	"github.com/x-research-team/swagin/swagger"
+)
+
+const orderKey = "order"
+
+func appendOrder(c *gin.Context, name string) {
+	order, _ := c.Value(orderKey).([]string)
+	c.Set(orderKey, append(order, name))
+}
+
+func orderMiddleware(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		appendOrder(c, name)
+	}
+}
+
+func orderHandler(c *gin.Context) {
+	appendOrder(c, "api")
+	order, _ := c.Value(orderKey).([]string)
+	c.String(http.StatusOK, strings.Join(order, " "))
+}
+
+type countBody struct {
+	Body struct {
+		Count int `json:"count"`
+	}
+}
+
+func postJSON(t *testing.T, app http.Handler, url, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	r.Header.Set("Content-Type", binding.MIMEJSON)
+	app.ServeHTTP(w, r)
+	return w
+}
+
+func TestMountThreeLevels(t *testing.T) {
+	var hooks []string
+	newApp := func(name string) *SwaGin {
+		app := New(swagger.New(name, "", "1.0.0"))
+		app.Middlewares(orderMiddleware(name))
+		app.GET("/x", router.NewX(orderHandler))
+		app.BeforeInit(func() { hooks = append(hooks, name+" before") })
+		app.AfterInit(func() { hooks = append(hooks, name+" after") })
+		return app
+	}
+	a, b, c := newApp("a"), newApp("b"), newApp("c")
+	a.WithErrorHandler(func(ctx *gin.Context, err error, status int) {
+		ctx.String(status, "handled")
+	})
+	c.POST("/count", router.New(func(ctx *gin.Context, req countBody) {
+		ctx.String(http.StatusOK, "counted")
+	}, router.OperationID("count")))
+	b.Mount("/c", c)
+	a.Mount("/b", b)
+	if err := a.InitE(); err != nil {
+		t.Fatal(err)
+	}
+
+	for url, want := range map[string]string{
+		"/x":     "a api",
+		"/b/x":   "a b api",
+		"/b/c/x": "a b c api",
+	} {
+		w := get(t, a, url)
+		if w.Code != http.StatusOK || w.Body.String() != want {
+			t.Errorf("GET %s = %d %q, want %d %q", url, w.Code, w.Body.String(), http.StatusOK, want)
+		}
+	}
+
+	wantHooks := []string{"a before", "b before", "c before", "c after", "b after", "a after"}
+	if !slices.Equal(hooks, wantHooks) {
+		t.Errorf("hooks = %v, want %v", hooks, wantHooks)
+	}
+
+	servers := c.Swagger.OpenAPI.Servers
+	if len(servers) == 0 || servers[len(servers)-1].URL != "/b/c" {
+		t.Errorf("servers of c = %v, want /b/c last", servers)
+	}
+	if w := get(t, a, "/b/c/openapi.json"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"url":"/b/c"`) {
+		t.Errorf("GET /b/c/openapi.json = %d %s, want the server /b/c", w.Code, w.Body.String())
+	}
+
+	// c inherits the error handler of a
+	if w := postJSON(t, a, "/b/c/count", `{"count":"x"}`); w.Code != http.StatusBadRequest || w.Body.String() != "handled" {
+		t.Errorf("POST /b/c/count = %d %q, want %d %q", w.Code, w.Body.String(), http.StatusBadRequest, "handled")
+	}
+	if w := postJSON(t, a, "/b/c/count", `{"count":1}`); w.Code != http.StatusOK || w.Body.String() != "counted" {
+		t.Errorf("POST /b/c/count = %d %q, want %d %q", w.Code, w.Body.String(), http.StatusOK, "counted")
+	}
+}
+
+func TestMountInheritsSecurity(t *testing.T) {
+	jwt := &security.JWT{Key: []byte("secret")}
+	app := New(swagger.New("Main", "", "1.0.0", swagger.Security(jwt)), MergeSubApps())
+	sub := New(swagger.New("Sub", "", "1.0.0"))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	sub.GET("/private", router.NewX(ok, router.OperationID("private")))
+	sub.GET("/public", router.NewX(ok, router.OperationID("public"), router.Public()))
+	app.Mount("/sub", sub)
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+
+	for url, want := range map[string]int{"/sub/private": http.StatusUnauthorized, "/sub/public": http.StatusOK} {
+		if w := get(t, app, url); w.Code != want {
+			t.Errorf("GET %s = %d, want %d", url, w.Code, want)
+		}
+	}
+	if got := sub.Swagger.OpenAPI.Security; len(got) != 1 || got[0][security.BearerAuth] == nil {
+		t.Errorf("security of the sub docs = %v, want %s", got, security.BearerAuth)
+	}
+	for path, public := range map[string]bool{"/sub/private": false, "/sub/public": true} {
+		operation := app.Swagger.OpenAPI.Paths.Find(path).Get
+		if operation.Security == nil || (len(*operation.Security) == 0) != public {
+			t.Errorf("merged security of %s = %v, want public %v", path, operation.Security, public)
+		}
+	}
+}
+
+func TestMergeKeepsPublic(t *testing.T) {
+	jwt := &security.JWT{Key: []byte("secret")}
+	dst := swagger.New("Main", "", "1.0.0", swagger.Security(jwt))
+	dst.BuildOpenAPI()
+	src := swagger.New("Sub", "", "1.0.0")
+	src.Routers = map[string]map[string]*router.Router{"/x": {http.MethodGet: router.NewX(func(c *gin.Context) {})}}
+	src.BuildOpenAPI()
+	if err := swagger.Merge(dst.OpenAPI, src.OpenAPI, "/sub", "Sub"); err != nil {
+		t.Fatal(err)
+	}
+	if operation := dst.OpenAPI.Paths.Find("/sub/x").Get; operation.Security == nil || len(*operation.Security) != 0 {
+		t.Errorf("merged security = %v, want public", operation.Security)
+	}
+}
@@ -0,0 +1,128 @@
+package swagin
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+
+	"github.com/x-research-team/swagin/swagger"
+)
+
+// CSPNonce is the context key of the nonce used for the scripts of the docs
+// pages, a middleware can set it to share its own nonce with the docs.
+const CSPNonce = "csp_nonce"
+
+var uiTitles = map[string]string{
+	swagger.SwaggerUI: "Swagger UI",
+	swagger.Redoc:     "ReDoc",
+	swagger.Scalar:    "Scalar",
+	swagger.RapiDoc:   "RapiDoc",
+	swagger.Elements:  "Elements",
+}
+
+func (g *SwaGin) initUIs() {
+	uis := g.Swagger.GetUIs()
+	t := g.parseTemplates(uis)
+	for _, ui := range uis {
+		g.docs.GET(g.fullPath(ui.Url), g.uiHandler(t, ui))
+		if ui.Name == swagger.SwaggerUI {
+			g.docs.GET(g.fullPath(oauth2RedirectUrl(ui.Url)), g.renderHandler(t, "oauth2-redirect.html", gin.H{}))
+		}
+	}
+}
+
+// oauth2RedirectUrl is the page Swagger UI returns to after an OAuth2 login.
+func oauth2RedirectUrl(url string) string {
+	return strings.TrimSuffix(url, "/") + "/oauth2-redirect.html"
+}
+
+// parseTemplates parses the docs templates into their own set, so they don't
+// replace the templates of the app set with SetHTMLTemplate. Templates from
+// Swagger.Templates override the built-in ones with the same name.
+func (g *SwaGin) parseTemplates(uis []*swagger.UI) *template.Template {
+	t := template.Must(template.ParseFS(templates, "templates/*.html"))
+	if g.Swagger.Templates != nil {
+		t = template.Must(t.ParseFS(g.Swagger.Templates, "*.html"))
+	}
+	for _, ui := range uis {
+		if ui.Template != "" {
+			template.Must(t.New(ui.Name + ".html").Parse(ui.Template))
+		}
+		if t.Lookup(ui.Name+".html") == nil {
+			panic(fmt.Sprintf("swagin: docs ui %q has no template", ui.Name))
+		}
+	}
+	return t
+}
+
+func (g *SwaGin) uiHandler(t *template.Template, ui *swagger.UI) gin.HandlerFunc {
+	options := maps.Clone(ui.Options)
+	if options == nil {
+		options = map[string]any{}
+	}
+	if _, ok := options["urls"]; !ok && ui.Name == swagger.SwaggerUI && len(g.versions) != 0 {
+		// list the docs of every version in the dropdown, the newest first
+		var urls []gin.H
+		for _, version := range slices.Backward(g.versions) {
+			urls = append(urls, gin.H{"url": g.versionUrl(version), "name": version})
+		}
+		options["urls"] = urls
+		options["urls.primaryName"] = g.versions[len(g.versions)-1]
+	}
+	title := uiTitles[ui.Name]
+	if title == "" {
+		title = ui.Name
+	}
+	data := gin.H{
+		"openapi_url": g.fullPath(g.Swagger.OpenAPIUrl),
+		"title":       g.Swagger.Title,
+		"page_title":  strings.NewReplacer("{title}", g.Swagger.Title, "{ui}", title).Replace(g.Swagger.TitleFormat),
+		"options":     options,
+		"assets_url":  g.assetsUrl(ui.Name),
+		"cdn":         g.Swagger.CDN,
+		"logo":        g.Swagger.Logo,
+		"favicon":     g.Swagger.Favicon,
+		"custom_css":  template.CSS(g.Swagger.CustomCSS),
+		"custom_js":   template.JS(g.Swagger.CustomJS),
+	}
+	if ui.Name == swagger.SwaggerUI {
+		data["oauth"] = g.Swagger.OAuth
+		data["oauth2_redirect_url"] = g.fullPath(oauth2RedirectUrl(ui.Url))
+		// the urls dropdown is part of the topbar of the standalone layout
+		_, data["standalone"] = options["urls"]
+	}
+	return g.renderHandler(t, ui.Name+".html", data)
+}
+
+// renderHandler renders the docs template name with a nonce for its scripts.
+func (g *SwaGin) renderHandler(t *template.Template, name string, data gin.H) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		nonce := c.GetString(CSPNonce)
+		if nonce == "" {
+			nonce = newNonce()
+			c.Set(CSPNonce, nonce)
+		}
+		if g.Swagger.CSP != "" {
+			c.Header("Content-Security-Policy", strings.ReplaceAll(g.Swagger.CSP, "{nonce}", nonce))
+		}
+		data := maps.Clone(data)
+		data["nonce"] = nonce
+		c.Render(http.StatusOK, render.HTML{Template: t, Name: name, Data: data})
+	}
+}
+
+func newNonce() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
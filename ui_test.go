@@ -0,0 +1,237 @@
+package swagin
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+
+	"github.com/x-research-team/swagin/security"
+	"github.com/x-research-team/swagin/swagger"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func get(t *testing.T, app http.Handler, url string) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+	return w
+}
+
+var (
+	nonceRegex       = regexp.MustCompile(`'nonce-([^']+)'`)
+	scriptNonceRegex = regexp.MustCompile(`<script nonce="([^"]+)">`)
+)
+
+func TestOAuth2Redirect(t *testing.T) {
+	app := New(swagger.New("Test", "", "1.0.0"))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	w := get(t, app, "/docs/oauth2-redirect.html")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	match := nonceRegex.FindStringSubmatch(w.Header().Get("Content-Security-Policy"))
+	if match == nil {
+		t.Fatalf("no nonce in Content-Security-Policy %q", w.Header().Get("Content-Security-Policy"))
+	}
+	// html/template escapes + in attributes, browsers unescape it
+	script := scriptNonceRegex.FindStringSubmatch(w.Body.String())
+	if script == nil || html.UnescapeString(script[1]) != match[1] {
+		t.Errorf("redirect script isn't nonced with %q:\n%s", match[1], w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "swaggerUIRedirectOauth2") {
+		t.Errorf("body isn't the redirect page:\n%s", w.Body.String())
+	}
+}
+
+func TestInitOAuth(t *testing.T) {
+	tests := []struct {
+		name  string
+		oauth *swagger.OAuthConfig
+		want  []string
+	}{
+		{name: "unset"},
+		{
+			name:  "set",
+			oauth: &swagger.OAuthConfig{ClientId: "docs", Scopes: []string{"read"}, UsePKCE: true},
+			want:  []string{`"clientId":"docs"`, `"scopes":["read"]`, `"usePkceWithAuthorizationCodeGrant":true`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New(swagger.New("Test", "", "1.0.0", swagger.OAuth(tt.oauth)))
+			if err := app.InitE(); err != nil {
+				t.Fatal(err)
+			}
+			body := get(t, app, "/docs").Body.String()
+			if !strings.Contains(body, `oauth2RedirectUrl: new URL("\/docs\/oauth2-redirect.html"`) {
+				t.Errorf("oauth2RedirectUrl isn't the redirect page:\n%s", body)
+			}
+			hasOAuth := strings.Contains(body, `id="swagger-oauth"`) && strings.Contains(body, "ui.initOAuth(")
+			if hasOAuth != (tt.oauth != nil) {
+				t.Fatalf("initOAuth rendered = %v, want %v:\n%s", hasOAuth, tt.oauth != nil, body)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(body, want) {
+					t.Errorf("body doesn't contain %s:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+var (
+	oauthConfigRegex = regexp.MustCompile(`<script id="swagger-oauth" type="application/json">(.*?)</script>`)
+	redirectURLRegex = regexp.MustCompile(`oauth2RedirectUrl: new URL\("([^"]+)"`)
+)
+
+// newAuthorizationServer is a stand-in authorization server of the
+// authorization code flow with PKCE, for the client docs.
+func newAuthorizationServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	challenges := make(map[string]string)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("response_type") != "code" || query.Get("client_id") != "docs" ||
+			query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+			http.Error(w, "invalid_request", http.StatusBadRequest)
+			return
+		}
+		redirect, err := url.Parse(query.Get("redirect_uri"))
+		if err != nil || !redirect.IsAbs() {
+			http.Error(w, "invalid_request", http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		challenges["code"] = query.Get("code_challenge")
+		mu.Unlock()
+		redirect.RawQuery = url.Values{"code": {"code"}, "state": {query.Get("state")}}.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		challenge, ok := challenges[r.PostFormValue("code")]
+		mu.Unlock()
+		sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOAuthLogin(t *testing.T) {
+	auth := newAuthorizationServer(t)
+	oauth := &security.OAuth2{AuthorizationURL: auth.URL + "/authorize", TokenURL: auth.URL + "/token"}
+	app := New(swagger.New("Test", "", "1.0.0", swagger.Security(oauth),
+		swagger.OAuth(&swagger.OAuthConfig{ClientId: "docs", UsePKCE: true})))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	docs := httptest.NewServer(app)
+	t.Cleanup(docs.Close)
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	fetch := func(u string) (*http.Response, string) {
+		t.Helper()
+		resp, err := client.Get(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp, string(body)
+	}
+
+	// the settings the page passes to Swagger UI
+	_, page := fetch(docs.URL + "/docs")
+	match := oauthConfigRegex.FindStringSubmatch(page)
+	if match == nil {
+		t.Fatalf("no initOAuth config in the page:\n%s", page)
+	}
+	var config swagger.OAuthConfig
+	if err := json.Unmarshal([]byte(match[1]), &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.ClientId != "docs" || !config.UsePKCE {
+		t.Errorf("initOAuth config = %+v, want client docs with PKCE", config)
+	}
+	match = redirectURLRegex.FindStringSubmatch(page)
+	if match == nil {
+		t.Fatalf("no oauth2RedirectUrl in the page:\n%s", page)
+	}
+	redirectURL := docs.URL + strings.ReplaceAll(match[1], `\/`, "/")
+
+	// the authorization url Swagger UI reads from the spec
+	_, spec := fetch(docs.URL + "/openapi.json")
+	var doc openapi3.T
+	if err := json.Unmarshal([]byte(spec), &doc); err != nil {
+		t.Fatal(err)
+	}
+	flow := doc.Components.SecuritySchemes[security.OAuth2Auth].Value.Flows.AuthorizationCode
+	if flow.AuthorizationURL != auth.URL+"/authorize" || flow.TokenURL != auth.URL+"/token" {
+		t.Fatalf("flow = %s %s, want the urls of the authorization server", flow.AuthorizationURL, flow.TokenURL)
+	}
+
+	// the login Swagger UI runs with these settings
+	verifier := "verifier-0123456789-0123456789-0123456789"
+	sum := sha256.Sum256([]byte(verifier))
+	resp, _ := fetch(flow.AuthorizationURL + "?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {config.ClientId},
+		"redirect_uri":          {redirectURL},
+		"state":                 {"state"},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode())
+	if resp.StatusCode != http.StatusFound || !strings.HasPrefix(resp.Header.Get("Location"), redirectURL+"?") {
+		t.Fatalf("authorize = %d to %q, want a redirect to %s", resp.StatusCode, resp.Header.Get("Location"), redirectURL)
+	}
+	resp, body := fetch(resp.Header.Get("Location"))
+	if resp.StatusCode != http.StatusOK || !strings.Contains(body, "swaggerUIRedirectOauth2") {
+		t.Fatalf("redirect page = %d, want the redirect page:\n%s", resp.StatusCode, body)
+	}
+	for v, want := range map[string]int{verifier: http.StatusOK, "other-verifier": http.StatusBadRequest} {
+		resp, err := http.PostForm(flow.TokenURL, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"code"},
+			"redirect_uri":  {redirectURL},
+			"client_id":     {config.ClientId},
+			"code_verifier": {v},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("token with verifier %q = %d, want %d", v, resp.StatusCode, want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package swagin
+
+import (
+	"fmt"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/swagger"
+)
+
+// Versions serve the routes of the app in versions, from the oldest to the
+// newest, under a path prefix like /v1 unless VersionByHeader or
+// VersionByAccept is used. Routes are served in every version unless
+// restricted with router.Versions or router.VersionRange, and each version
+// gets its own docs.
+func Versions(versions ...string) GinOption {
+	return func(g *SwaGin) {
+		g.versions = append(g.versions, versions...)
+	}
+}
+
+// VersionByHeader select the version of a request with the header name
+// instead of the path, the newest version is used when the header is missing
+func VersionByHeader(name string) GinOption {
+	return func(g *SwaGin) {
+		g.versionHeader = name
+		g.versionOf = func(c *gin.Context) string {
+			return c.GetHeader(name)
+		}
+	}
+}
+
+// VersionByAccept select the version of a request with the version parameter
+// of the Accept header, like application/json; version=v2, instead of the
+// path, the newest version is used when the parameter is missing
+func VersionByAccept() GinOption {
+	return func(g *SwaGin) {
+		g.versionHeader = "Accept"
+		g.versionOf = func(c *gin.Context) string {
+			for _, accept := range strings.Split(c.GetHeader("Accept"), ",") {
+				if _, params, err := mime.ParseMediaType(accept); err == nil && params["version"] != "" {
+					return params["version"]
+				}
+			}
+			return ""
+		}
+	}
+}
+
+// versionKey is the key of the version of the request in the context.
+const versionKey = "swagin_version"
+
+// versionRouters returns the routers of every version by path and method,
+// routes with an unknown version or registered twice in a version are
+// reported and left out.
+func (g *SwaGin) versionRouters() (map[string]map[string]map[string]*router.Router, []error) {
+	versions := make(map[string]map[string]map[string]*router.Router, len(g.versions))
+	for _, version := range g.versions {
+		versions[version] = make(map[string]map[string]*router.Router)
+	}
+	var errs []error
+routes:
+	for _, r := range g.versioned {
+		for _, version := range slices.Concat(r.Versions, []string{r.VersionFrom, r.VersionTo}) {
+			if version != "" && !slices.Contains(g.versions, version) {
+				errs = append(errs, fmt.Errorf("%s %s: unknown version %q", r.Method, r.Path, version))
+				continue routes
+			}
+		}
+		for _, version := range g.versions {
+			if !r.InVersion(version, g.versions) {
+				continue
+			}
+			routers := versions[version]
+			if routers[r.Path] == nil {
+				routers[r.Path] = make(map[string]*router.Router)
+			}
+			if routers[r.Path][r.Method] != nil {
+				errs = append(errs, fmt.Errorf("%s %s is registered twice in version %q", r.Method, r.Path, version))
+				continue
+			}
+			routers[r.Path][r.Method] = r
+		}
+	}
+	return versions, errs
+}
+
+// initVersions registers the routes of every version, under the path prefix
+// of the version or behind a handler selecting the version of the request.
+func (g *SwaGin) initVersions(routes gin.IRoutes, versions map[string]map[string]map[string]*router.Router) {
+	if g.versionOf == nil {
+		for _, version := range g.versions {
+			g.initRouters(routes, "/"+version, versions[version])
+		}
+		return
+	}
+	byPath := make(map[string]map[string]map[string]*router.Router)
+	for version, routers := range versions {
+		for path, m := range routers {
+			if byPath[path] == nil {
+				byPath[path] = make(map[string]map[string]*router.Router)
+			}
+			for method, r := range m {
+				if byPath[path][method] == nil {
+					byPath[path][method] = make(map[string]*router.Router)
+				}
+				byPath[path][method][version] = r
+			}
+		}
+	}
+	for _, path := range slices.Sorted(maps.Keys(byPath)) {
+		for _, method := range slices.Sorted(maps.Keys(byPath[path])) {
+			handle(routes, method, g.fullPath(path), g.versionHandlers(byPath[path][method])...)
+		}
+	}
+}
+
+// versionHandlers returns a chain selecting the version of the request then
+// running, at each position, the handler of the router of that version at
+// the same position, so that c.Next runs the rest of the chain of the version.
+func (g *SwaGin) versionHandlers(routers map[string]*router.Router) []gin.HandlerFunc {
+	byVersion := make(map[string][]gin.HandlerFunc, len(routers))
+	length := 0
+	for version, r := range routers {
+		byVersion[version] = g.handlers(r)
+		length = max(length, len(byVersion[version]))
+	}
+	newest := g.versions[len(g.versions)-1]
+	handlers := []gin.HandlerFunc{func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", g.versionHeader)
+		version := g.versionOf(c)
+		if version == "" {
+			version = newest
+		}
+		if _, ok := byVersion[version]; !ok {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		c.Set(versionKey, version)
+	}}
+	for i := 0; i < length; i++ {
+		handlers = append(handlers, func(c *gin.Context) {
+			if hs := byVersion[c.GetString(versionKey)]; i < len(hs) {
+				hs[i](c)
+			}
+		})
+	}
+	return handlers
+}
+
+// buildVersions builds the docs of every version, g.Swagger holds the docs
+// of the newest version. Routes that the newest version serves with another
+// router, or not at all, are deprecated in the older versions.
+func (g *SwaGin) buildVersions(versions map[string]map[string]map[string]*router.Router) []error {
+	newest := g.versions[len(g.versions)-1]
+	g.versionDocs = make(map[string]*swagger.Swagger, len(g.versions))
+	var errs []error
+	for _, version := range g.versions {
+		sw := g.Swagger
+		routers := versions[version]
+		if version != newest {
+			copied := *g.Swagger
+			sw = &copied
+			routers = make(map[string]map[string]*router.Router, len(versions[version]))
+			for path, m := range versions[version] {
+				routers[path] = make(map[string]*router.Router, len(m))
+				for method, r := range m {
+					if versions[newest][path][method] != r && !r.Deprecated {
+						deprecated := *r
+						deprecated.Deprecated = true
+						r = &deprecated
+					}
+					routers[path][method] = r
+				}
+			}
+		}
+		sw.Routers = routers
+		sw.Version = version
+		prefix := ""
+		if g.versionOf == nil {
+			prefix = "/" + version
+		}
+		g.buildSwagger(sw, prefix)
+		g.versionDocs[version] = sw
+		if version != newest {
+			if err := sw.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("version %s: %w", version, err))
+			}
+		}
+	}
+	return errs
+}
+
+// versionUrl is the url of the docs of version.
+func (g *SwaGin) versionUrl(version string) string {
+	return g.fullPath("/" + version + g.Swagger.OpenAPIUrl)
+}
@@ -0,0 +1,131 @@
+package swagin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/x-research-team/swagin/router"
+	"github.com/x-research-team/swagin/swagger"
+)
+
+func TestVersionByHeaderNext(t *testing.T) {
+	around := func(c *gin.Context) {
+		appendOrder(c, "before")
+		c.Next()
+		appendOrder(c, "after")
+		order, _ := c.Value(orderKey).([]string)
+		c.Writer.Header().Set("X-Order", strings.Join(order, " "))
+	}
+	api := func(name string) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			appendOrder(c, name)
+			c.Status(http.StatusOK)
+		}
+	}
+	app := New(swagger.New("Test", "", "1.0.0"), Versions("v1", "v2"), VersionByHeader("X-Version"))
+	app.GET("/x", router.NewX(api("v1"), router.Versions("v1"), router.Handlers(around)))
+	app.GET("/x", router.NewX(api("v2"), router.Versions("v2")))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	for version, want := range map[string]string{
+		"v1": "before v1 after",
+		"v2": "",
+		"":   "",
+		"v3": "",
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/x", nil)
+		r.Header.Set("X-Version", version)
+		app.ServeHTTP(w, r)
+		wantCode := http.StatusOK
+		if version == "v3" {
+			wantCode = http.StatusNotFound
+		}
+		if w.Code != wantCode || w.Header().Get("X-Order") != want {
+			t.Errorf("version %q = %d %q, want %d %q", version, w.Code, w.Header().Get("X-Order"), wantCode, want)
+		}
+	}
+}
+
+func TestVersionErrors(t *testing.T) {
+	app := New(swagger.New("Test", "", "1.0.0"), Versions("v1", "v2"))
+	app.GET("/unknown", router.NewX(func(c *gin.Context) {}, router.Versions("v3")))
+	app.GET("/twice", router.NewX(func(c *gin.Context) {}))
+	app.GET("/twice", router.NewX(func(c *gin.Context) {}, router.Versions("v2")))
+	err := app.InitE()
+	if err == nil {
+		t.Fatal("InitE() = nil, want the version errors")
+	}
+	for _, want := range []string{`GET /unknown: unknown version "v3"`, `GET /twice is registered twice in version "v2"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("InitE() = %v, want %s", err, want)
+		}
+	}
+	if w := get(t, app, "/v1/twice"); w.Code != http.StatusOK {
+		t.Errorf("GET /v1/twice = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestVersionValidate(t *testing.T) {
+	app := New(swagger.New("Test", "", "1.0.0"), Versions("v1", "v2"))
+	app.GET("/users/:id", router.NewX(func(c *gin.Context) {}, router.OperationID("getUser")))
+	err := app.InitE()
+	if err == nil {
+		t.Fatal("InitE() = nil, want the missing uri field reported")
+	}
+	// once in the docs of each version
+	if want := `GET /users/:id: path parameter "id" has no matching`; strings.Count(err.Error(), want) != 2 {
+		t.Errorf("InitE() = %v, want %s reported for v1 and v2", err, want)
+	}
+}
+
+func TestMergeVersionedSubApp(t *testing.T) {
+	app := New(swagger.New("Main", "", "1.0.0"), MergeSubApps())
+	sub := New(swagger.New("Sub", "", "1.0.0"), Versions("v1", "v2"))
+	sub.GET("/x", router.NewX(func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}, router.OperationID("x")))
+	app.Mount("/sub", sub)
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	if w := get(t, app, "/sub/v2/x"); w.Code != http.StatusOK {
+		t.Fatalf("GET /sub/v2/x = %d, want %d", w.Code, http.StatusOK)
+	}
+	if app.Swagger.OpenAPI.Paths.Find("/sub/v2/x") == nil {
+		t.Errorf("merged paths = %v, want /sub/v2/x", app.Swagger.OpenAPI.Paths.InMatchingOrder())
+	}
+}
+
+func TestVersionDropdown(t *testing.T) {
+	preset := "/docs-assets/swagger-ui@" + SwaggerUIVersion + "/swagger-ui-standalone-preset.js"
+	for _, versions := range []bool{false, true} {
+		var options []GinOption
+		if versions {
+			options = append(options, Versions("v1", "v2"))
+		}
+		app := New(swagger.New("Test", "", "1.0.0"), options...)
+		if err := app.InitE(); err != nil {
+			t.Fatal(err)
+		}
+		page := get(t, app, "/docs").Body.String()
+		for _, part := range []string{preset, "SwaggerUIStandalonePreset", `layout: "StandaloneLayout"`} {
+			if strings.Contains(page, part) != versions {
+				t.Errorf("docs page with versions %v contains %q = %v, want %v", versions, part, !versions, versions)
+			}
+		}
+	}
+
+	app := New(swagger.New("Test", "", "1.0.0"), Versions("v1"))
+	if err := app.InitE(); err != nil {
+		t.Fatal(err)
+	}
+	if w := get(t, app, preset); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "SwaggerUIStandalonePreset") {
+		t.Errorf("GET %s = %d, want the standalone preset", preset, w.Code)
+	}
+}